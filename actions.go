@@ -46,6 +46,11 @@ func buildWeeklyActions(league LeagueData) []Action {
 		}
 	}
 
+	// 5. Taxi squad moves (dynasty only)
+	if league.IsDynasty && len(league.TaxiSuggestions) > 0 {
+		actions = append(actions, findTaxiMoves(league, weekID)...)
+	}
+
 	// Sort by priority (lower number = higher priority)
 	sort.Slice(actions, func(i, j int) bool {
 		return actions[i].Priority < actions[j].Priority
@@ -95,6 +100,8 @@ func findStarterSwaps(league LeagueData, weekID string) []Action {
 					Description: fmt.Sprintf("Start %s over %s", bench.Name, starter.Name),
 					Impact:      fmt.Sprintf("+%.1f tier upgrade", tierDiff),
 					Link:        fmt.Sprintf("#player-%s", normalizeAnchor(bench.Name)),
+					PlayerName:  stripHTML(bench.Name),
+					VersusName:  stripHTML(starter.Name),
 					WeekID:      weekID,
 				})
 			}
@@ -135,13 +142,26 @@ func findWaiverTargets(league LeagueData, weekID string) []Action {
 				}
 				seen[key] = true
 
+				description := fmt.Sprintf("%s available (would start over %s)", fa.Name, starter.Name)
+				versus := stripHTML(starter.Name)
+				drop, needsDrop, warning := pairDropCandidate(fa, league)
+				if needsDrop && drop.Player.Name != "" {
+					description += fmt.Sprintf(" - drop %s", stripHTML(drop.Player.Name))
+					versus = stripHTML(drop.Player.Name)
+				}
+				if warning != "" {
+					description += fmt.Sprintf(" (%s)", warning)
+				}
+
 				actions = append(actions, Action{
 					Priority:    2,
 					Category:    "waiver",
 					Title:       "Check Waiver Wire",
-					Description: fmt.Sprintf("%s available (would start over %s)", fa.Name, starter.Name),
+					Description: description,
 					Impact:      fmt.Sprintf("+%.1f tier upgrade", tierDiff),
 					Link:        fmt.Sprintf("#fa-%s", normalizeAnchor(fa.Name)),
+					PlayerName:  stripHTML(fa.Name),
+					VersusName:  versus,
 					WeekID:      weekID,
 				})
 
@@ -201,6 +221,41 @@ func findTradeOpportunity(league LeagueData, weekID string) Action {
 	return Action{}
 }
 
+func findTaxiMoves(league LeagueData, weekID string) []Action {
+	var actions []Action
+
+	for _, s := range league.TaxiSuggestions {
+		switch s.Type {
+		case "promote":
+			priority := 3
+			if league.HasMatchups {
+				priority = 2 // Promotion can improve this week's lineup
+			}
+			actions = append(actions, Action{
+				Priority:    priority,
+				Category:    "taxi",
+				Title:       "Promote From Taxi",
+				Description: fmt.Sprintf("Activate %s over %s", s.PlayerName, s.Counterpart),
+				Impact:      s.Reason,
+				Link:        "#taxi-squad",
+				WeekID:      weekID,
+			})
+		case "expiring":
+			actions = append(actions, Action{
+				Priority:    4,
+				Category:    "taxi",
+				Title:       "Taxi Eligibility Ending",
+				Description: fmt.Sprintf("%s is in their final taxi-eligible season", s.PlayerName),
+				Impact:      "Plan a promotion before next season",
+				Link:        "#taxi-squad",
+				WeekID:      weekID,
+			})
+		}
+	}
+
+	return actions
+}
+
 func parseTierFloat(tier interface{}) float64 {
 	switch v := tier.(type) {
 	case float64:
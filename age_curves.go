@@ -0,0 +1,119 @@
+// ABOUTME: Position-specific age curves projecting dynasty value one to three seasons ahead
+// ABOUTME: Drives sell-high, breakout and buy-low lists, future roster value, and trade future impact
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+const AGE_CURVE_YEARS = 3 // Seasons projected ahead
+
+// ageCurveStep is the expected year-over-year value change for players up to MaxAge
+type ageCurveStep struct {
+	MaxAge int
+	Change float64 // e.g. -0.12 = loses 12% of value over the next season
+}
+
+// positionAgeCurves encode typical dynasty value arcs: RBs peak early and fall fast,
+// receivers and tight ends hold into their late 20s, quarterbacks age slowest
+var positionAgeCurves = map[string][]ageCurveStep{
+	"RB": {{22, 0.08}, {24, 0.03}, {25, -0.05}, {26, -0.12}, {27, -0.20}, {99, -0.28}},
+	"WR": {{23, 0.10}, {25, 0.04}, {27, 0.00}, {28, -0.08}, {29, -0.15}, {99, -0.22}},
+	"TE": {{24, 0.10}, {26, 0.05}, {28, 0.00}, {29, -0.10}, {99, -0.20}},
+	"QB": {{25, 0.06}, {30, 0.01}, {33, -0.04}, {35, -0.12}, {99, -0.25}},
+}
+
+// ageCurveChange returns the expected value change over the season after the given age
+func ageCurveChange(pos string, age int) float64 {
+	for _, step := range positionAgeCurves[pos] {
+		if age <= step.MaxAge {
+			return step.Change
+		}
+	}
+	return 0
+}
+
+// projectValue compounds the age curve over the given number of seasons.
+// Unknown ages and positions without a curve (picks, kickers) hold their value.
+func projectValue(pos string, age, value, years int) int {
+	if value <= 0 || age <= 0 {
+		return value
+	}
+	projected := float64(value)
+	for y := 0; y < years; y++ {
+		projected *= 1 + ageCurveChange(pos, age+y)
+	}
+	return int(math.Round(projected))
+}
+
+// valueProjection returns projected values 1..AGE_CURVE_YEARS seasons out
+func valueProjection(pos string, age, value int) []int {
+	out := make([]int, AGE_CURVE_YEARS)
+	for y := 1; y <= AGE_CURVE_YEARS; y++ {
+		out[y-1] = projectValue(pos, age, value, y)
+	}
+	return out
+}
+
+// enrichRowsWithProjections fills ValueProjection for rows with a dynasty value and age
+func enrichRowsWithProjections(rows []PlayerRow) {
+	for i := range rows {
+		if rows[i].DynastyValue > 0 && rows[i].Age > 0 {
+			rows[i].ValueProjection = valueProjection(rowPosition(rows[i]), rows[i].Age, rows[i].DynastyValue)
+		}
+	}
+}
+
+// projectedRosterValue sums every row's projected value the given number of seasons out
+func projectedRosterValue(rows []PlayerRow, years int) int {
+	total := 0
+	for _, row := range rows {
+		total += projectValue(rowPosition(row), row.Age, row.DynastyValue, years)
+	}
+	return total
+}
+
+// projectedChangePct is a row's projected value change over the given seasons, in percent
+func projectedChangePct(row PlayerRow, years int) float64 {
+	if row.DynastyValue <= 0 {
+		return 0
+	}
+	projected := projectValue(rowPosition(row), row.Age, row.DynastyValue, years)
+	return float64(projected-row.DynastyValue) / float64(row.DynastyValue) * 100
+}
+
+// findBuyLowTargets lists players on other rosters the curve expects to gain the most value
+// over the next two seasons
+func findBuyLowTargets(allRosters map[int][]PlayerRow, teamNames map[int]string, userRosterID int, limit int) []BuyLowTarget {
+	targets := []BuyLowTarget{}
+	for rosterID, rows := range allRosters {
+		if rosterID == userRosterID {
+			continue
+		}
+		for _, row := range rows {
+			if row.DynastyValue < 1000 || row.Age <= 0 {
+				continue
+			}
+			gain := projectedChangePct(row, 2)
+			if gain < 10 {
+				continue
+			}
+			row.ValueProjection = valueProjection(rowPosition(row), row.Age, row.DynastyValue)
+			targets = append(targets, BuyLowTarget{Player: row, TeamName: teamNames[rosterID], ProjectedGainPct: gain})
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		gi := targets[i].Player.ValueProjection[1] - targets[i].Player.DynastyValue
+		gj := targets[j].Player.ValueProjection[1] - targets[j].Player.DynastyValue
+		if gi != gj {
+			return gi > gj
+		}
+		return targets[i].Player.Name < targets[j].Player.Name
+	})
+	if limit > 0 && len(targets) > limit {
+		targets = targets[:limit]
+	}
+	return targets
+}
@@ -0,0 +1,87 @@
+package main
+
+import "testing"
+
+func TestProjectValueFollowsPositionCurves(t *testing.T) {
+	// A 22-year-old WR appreciates; a 28-year-old RB falls off fast
+	if got := projectValue("WR", 22, 5000, 1); got != 5500 {
+		t.Fatalf("expected young WR to gain 10%%, got %d", got)
+	}
+	if got := projectValue("RB", 28, 5000, 2); got != 2592 {
+		t.Fatalf("expected two steep RB declines, got %d", got)
+	}
+	// QBs age slowest: a 30-year-old QB holds value better than a 30-year-old WR
+	if projectValue("QB", 30, 5000, 3) <= projectValue("WR", 30, 5000, 3) {
+		t.Fatalf("expected QB to outlast WR at the same age")
+	}
+	// Picks and unknown ages hold value
+	if projectValue("PICK", 0, 3000, 3) != 3000 || projectValue("WR", 0, 3000, 3) != 3000 {
+		t.Fatalf("expected value without a curve to hold")
+	}
+
+	proj := valueProjection("TE", 24, 1000)
+	if len(proj) != AGE_CURVE_YEARS || proj[0] != 1100 || proj[1] != 1155 || proj[2] != 1213 {
+		t.Fatalf("unexpected TE projection: %v", proj)
+	}
+}
+
+func TestSellHighAndBreakoutLists(t *testing.T) {
+	starters := []PlayerRow{
+		{Name: "Old Back", Pos: "RB", Age: 27, DynastyValue: 4000},
+		{Name: "Older Back", Pos: "RB", Age: 29, DynastyValue: 2000},
+		{Name: "Prime WR", Pos: "WR", Age: 26, DynastyValue: 6000},
+		{Name: "Old Cheap", Pos: "WR", Age: 31, DynastyValue: 900},
+	}
+	bench := []PlayerRow{
+		{Name: "Young WR", Pos: "FLEX", RealPos: "WR", Age: 21, DynastyValue: 2000},
+		{Name: "Younger TE", Pos: "TE", Age: 22, DynastyValue: 600},
+		{Name: "Young QB", Pos: "QB", Age: 22, DynastyValue: 3000},
+	}
+
+	aging := findAgingPlayers(starters, bench)
+	if len(aging) != 2 || aging[0].Name != "Old Back" || aging[1].Name != "Older Back" {
+		t.Fatalf("expected sell-high list ordered by projected loss, got %+v", aging)
+	}
+	if len(aging[0].ValueProjection) != AGE_CURVE_YEARS {
+		t.Fatalf("expected projections on sell-high rows")
+	}
+
+	breakouts := findBreakoutCandidates(bench)
+	if len(breakouts) != 2 || breakouts[0].Name != "Young WR" {
+		t.Fatalf("expected curve-based breakouts with real position, got %+v", breakouts)
+	}
+}
+
+func TestProjectedRosterValueAndBuyLow(t *testing.T) {
+	rows := []PlayerRow{{Pos: "WR", Age: 22, DynastyValue: 1000}, {Pos: "RB", Age: 30, DynastyValue: 1000}}
+	if got := projectedRosterValue(rows, 1); got != 1100+720 {
+		t.Fatalf("unexpected projected roster value %d", got)
+	}
+
+	rosters := map[int][]PlayerRow{
+		1: {{Name: "Mine", Pos: "WR", Age: 21, DynastyValue: 5000}},
+		2: {{Name: "Riser", Pos: "WR", Age: 21, DynastyValue: 4000}, {Name: "Vet", Pos: "WR", Age: 30, DynastyValue: 4000}},
+		3: {{Name: "Small Riser", Pos: "TE", Age: 23, DynastyValue: 1500}, {Name: "Tiny", Pos: "WR", Age: 21, DynastyValue: 500}},
+	}
+	targets := findBuyLowTargets(rosters, map[int]string{2: "Team Two", 3: "Team Three"}, 1, 10)
+	if len(targets) != 2 || targets[0].Player.Name != "Riser" || targets[0].TeamName != "Team Two" || targets[1].Player.Name != "Small Riser" {
+		t.Fatalf("unexpected buy-low targets: %+v", targets)
+	}
+	if targets[0].ProjectedGainPct < 10 {
+		t.Fatalf("expected projected gain recorded, got %+v", targets[0])
+	}
+}
+
+func TestFutureImpactUsesAgeCurves(t *testing.T) {
+	young := []ProposalPlayer{{Name: "Young", Position: "WR", Age: 22, DynastyValue: 5000}}
+	old := []ProposalPlayer{{Name: "Old", Position: "RB", Age: 28, DynastyValue: 5000}}
+	if impact := calculateFutureImpact(old, young, nil); impact <= 0 {
+		t.Fatalf("expected receiving the younger player to help the future, got %d", impact)
+	}
+	if impact := calculateFutureImpact(young, old, nil); impact >= 0 {
+		t.Fatalf("expected receiving the older player to hurt the future, got %d", impact)
+	}
+	if age := estimateAverageAge(young); age != 22 {
+		t.Fatalf("expected actual age to be used, got %.1f", age)
+	}
+}
@@ -0,0 +1,103 @@
+// ABOUTME: All-play records and luck index from completed weekly matchups
+// ABOUTME: Compares each team's weekly score against every other team to separate skill from schedule luck
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// calculateAllPlayRecords plays every team against every other team each completed week.
+// Luck is actual head-to-head wins minus the wins expected from the all-play win rate.
+func calculateAllPlayRecords(weekly map[int][]map[string]interface{}, standings []StandingsEntry) []AllPlayRecord {
+	records := make(map[int]*AllPlayRecord)
+	for _, e := range standings {
+		records[e.RosterID] = &AllPlayRecord{RosterID: e.RosterID, TeamName: e.TeamName, IsUserTeam: e.IsUserTeam}
+	}
+
+	for _, matchups := range weekly {
+		scores := make(map[int]float64)
+		for _, m := range matchups {
+			rid, ok := m["roster_id"].(float64)
+			if !ok {
+				continue
+			}
+			scores[int(rid)] = matchupPoints(m)
+		}
+		if !weekHasScores(scores) {
+			continue // Week not played yet
+		}
+
+		for rid, score := range scores {
+			rec, ok := records[rid]
+			if !ok {
+				continue
+			}
+			for other, otherScore := range scores {
+				if other == rid {
+					continue
+				}
+				switch {
+				case score > otherScore:
+					rec.Wins++
+				case score < otherScore:
+					rec.Losses++
+				default:
+					rec.Ties++
+				}
+			}
+
+			// Actual head-to-head result (median games excluded)
+			if _, yours, theirs, ok := matchupOpponent(matchups, rid); ok {
+				rec.Games++
+				if yours > theirs {
+					rec.ActualWins++
+				} else if yours < theirs {
+					rec.ActualLosses++
+				}
+			}
+		}
+	}
+
+	result := []AllPlayRecord{}
+	for _, rec := range records {
+		total := rec.Wins + rec.Losses + rec.Ties
+		if total == 0 {
+			continue
+		}
+		rec.WinPct = (float64(rec.Wins) + float64(rec.Ties)/2) / float64(total)
+		rec.ExpectedWins = math.Round(rec.WinPct*float64(rec.Games)*10) / 10
+		rec.Luck = math.Round((float64(rec.ActualWins)-rec.WinPct*float64(rec.Games))*10) / 10
+		result = append(result, *rec)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].WinPct != result[j].WinPct {
+			return result[i].WinPct > result[j].WinPct
+		}
+		return result[i].RosterID < result[j].RosterID
+	})
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+	return result
+}
+
+func weekHasScores(scores map[int]float64) bool {
+	for _, s := range scores {
+		if s > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// allPlayByRoster indexes all-play records by roster ID
+func allPlayByRoster(records []AllPlayRecord) map[int]AllPlayRecord {
+	m := make(map[int]AllPlayRecord, len(records))
+	for _, r := range records {
+		m[r.RosterID] = r
+	}
+	return m
+}
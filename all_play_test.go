@@ -0,0 +1,69 @@
+package main
+
+import "testing"
+
+func TestCalculateAllPlayRecords(t *testing.T) {
+	standings := []StandingsEntry{
+		{RosterID: 1, TeamName: "A", IsUserTeam: true},
+		{RosterID: 2, TeamName: "B"},
+		{RosterID: 3, TeamName: "C"},
+		{RosterID: 4, TeamName: "D"},
+	}
+	weekly := map[int][]map[string]interface{}{
+		// Team 1 scores second-most but loses to the top scorer
+		1: {matchupEntry(1, 1, 120), matchupEntry(2, 1, 130), matchupEntry(3, 2, 100), matchupEntry(4, 2, 90)},
+		2: {matchupEntry(1, 1, 125), matchupEntry(3, 1, 140), matchupEntry(2, 2, 80), matchupEntry(4, 2, 85)},
+		// Unplayed week is ignored
+		3: {matchupEntry(1, 1, 0), matchupEntry(4, 1, 0), matchupEntry(2, 2, 0), matchupEntry(3, 2, 0)},
+	}
+
+	records := calculateAllPlayRecords(weekly, standings)
+	if len(records) != 4 {
+		t.Fatalf("expected 4 records, got %d", len(records))
+	}
+	byRoster := allPlayByRoster(records)
+
+	user := byRoster[1]
+	if user.Wins != 4 || user.Losses != 2 || user.Games != 2 {
+		t.Fatalf("expected 4-2 all-play over 2 games, got %+v", user)
+	}
+	if user.ActualWins != 0 || user.ActualLosses != 2 {
+		t.Fatalf("expected 0-2 actual record, got %d-%d", user.ActualWins, user.ActualLosses)
+	}
+	if user.ExpectedWins != 1.3 || user.Luck != -1.3 {
+		t.Fatalf("expected 1.3 expected wins and -1.3 luck, got %.1f / %.1f", user.ExpectedWins, user.Luck)
+	}
+	if !user.IsUserTeam {
+		t.Fatalf("expected user team flag to carry over")
+	}
+
+	// Team 4 beat team 2 in week 2 despite scoring below most of the league
+	if byRoster[4].Luck <= 0 {
+		t.Fatalf("expected team 4 to be lucky, got %+v", byRoster[4])
+	}
+	if records[0].Rank != 1 || records[0].WinPct < records[len(records)-1].WinPct {
+		t.Fatalf("expected records sorted by all-play win pct: %+v", records)
+	}
+}
+
+func TestPowerRankingsUseAllPlay(t *testing.T) {
+	standings := []StandingsEntry{
+		{RosterID: 1, TeamName: "Lucky", Rank: 1, Wins: 2},
+		{RosterID: 2, TeamName: "Unlucky", Rank: 2, Losses: 2},
+		{RosterID: 3, TeamName: "C", Rank: 3, Wins: 1, Losses: 1},
+		{RosterID: 4, TeamName: "D", Rank: 4, Wins: 1, Losses: 1},
+	}
+	weekly := map[int][]map[string]interface{}{
+		1: {matchupEntry(1, 1, 90), matchupEntry(2, 1, 85), matchupEntry(3, 2, 100), matchupEntry(4, 2, 80)},
+		2: {matchupEntry(1, 1, 95), matchupEntry(2, 1, 92), matchupEntry(3, 2, 70), matchupEntry(4, 2, 75)},
+	}
+	rankings := calculatePowerRankings(powerRankingInputs{Standings: standings, Weekly: weekly, Weights: PowerRankingWeights{Record: 1}})
+	for _, r := range rankings {
+		if r.AllPlayRank == 0 {
+			t.Fatalf("expected all-play data on %s", r.TeamName)
+		}
+		if r.TeamName == "Lucky" && r.Luck <= 0 {
+			t.Fatalf("expected positive luck to carry over, got %.1f", r.Luck)
+		}
+	}
+}
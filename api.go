@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -61,3 +62,84 @@ func (a *APIClient) FetchDynastyValues(ctx context.Context) (map[string]interfac
 func (a *APIClient) FetchPlayers(ctx context.Context) (map[string]interface{}, error) {
 	return fetchPlayers()
 }
+
+// RunBacktest replays archived weekly snapshots through the waiver and action models.
+// Returns the report (for JSON output) and a formatted summary.
+func (a *APIClient) RunBacktest(ctx context.Context, leagueID string, horizon int) (interface{}, string, error) {
+	dir := snapshotArchiveDir()
+	snaps, err := loadWeeklySnapshots(dir, leagueID)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(snaps) == 0 {
+		return nil, "", fmt.Errorf("no snapshots archived in %s", dir)
+	}
+	state, err := a.provider.FetchNFLState()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch NFL state: %w", err)
+	}
+
+	loaded := map[string]map[string]PlayerPoints{}
+	points := func(season string, week int) (map[string]PlayerPoints, bool) {
+		if !weekComplete(state, season, week) {
+			return nil, false
+		}
+		key := fmt.Sprintf("%s-%d", season, week)
+		if wp, ok := loaded[key]; ok {
+			return wp, true
+		}
+		wp, err := loadWeekPoints(dir, season, week, a.provider)
+		if err != nil {
+			debugLog("[DEBUG] No points for %s week %d: %v", season, week, err)
+			return nil, false
+		}
+		loaded[key] = wp
+		return wp, true
+	}
+
+	report := runBacktest(snaps, horizon, points)
+	return report, formatBacktestReport(report), nil
+}
+
+// EvaluateTrade evaluates the user's side of a trade with the partner roster ID. A partner of 0 is the
+// team that owns what they get; a give-only trade must name one.
+// Returns the evaluation (for JSON output) and a formatted summary.
+func (a *APIClient) EvaluateTrade(ctx context.Context, leagueID, username string, partner int, give, get []string) (interface{}, string, error) {
+	page, err := buildTradeEvaluatorPage(leagueID, username, DraftOrderReverseStandings, partner, give, get)
+	if err != nil {
+		return nil, "", err
+	}
+	if page.Error == errTradePartnerRequired {
+		teams := []string{}
+		for _, t := range page.Teams {
+			teams = append(teams, fmt.Sprintf("%d=%s", t.RosterID, t.Name))
+		}
+		return nil, "", fmt.Errorf("%s Pass their roster ID (%s)", page.Error, strings.Join(teams, ", "))
+	}
+	if page.Error != "" {
+		return nil, "", fmt.Errorf("%s", page.Error)
+	}
+	if page.Evaluation == nil {
+		return nil, "", fmt.Errorf("no players or picks to evaluate")
+	}
+	return page.Evaluation, formatTradeEvaluation(*page.Evaluation), nil
+}
+
+// weekComplete reports whether a regular-season week has been played according to Sleeper's NFL state
+func weekComplete(state map[string]interface{}, season string, week int) bool {
+	current, _ := state["season"].(string)
+	if season < current {
+		return true
+	}
+	if season > current {
+		return false
+	}
+	currentWeek, _ := state["week"].(float64)
+	switch state["season_type"] {
+	case "regular":
+		return week < int(currentWeek)
+	case "pre":
+		return false
+	}
+	return true // Postseason or offseason: the whole regular season is in
+}
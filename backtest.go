@@ -0,0 +1,223 @@
+// ABOUTME: Offline backtest replaying archived weekly snapshots through the waiver and action models
+// ABOUTME: Reports how often recommended adds and swaps outscored the player they displaced
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	BACKTEST_DEFAULT_HORIZON = 3  // Weeks of scoring compared after a waiver pickup
+	BACKTEST_RECOMMENDATIONS = 10 // Waiver recommendations replayed per snapshot
+)
+
+// weekPointsFunc returns points for a completed week, or false when the week hasn't been played
+type weekPointsFunc func(season string, week int) (map[string]PlayerPoints, bool)
+
+// snapshotPlayerIDs maps stripped display names to Sleeper IDs for every row in a snapshot
+func snapshotPlayerIDs(snap WeeklySnapshot) map[string]string {
+	ids := map[string]string{}
+	add := func(rows []PlayerRow) {
+		for _, row := range rows {
+			if row.PlayerID != "" {
+				ids[normalizeName(stripHTML(row.Name))] = row.PlayerID
+			}
+		}
+	}
+	l := snap.League
+	for _, rows := range [][]PlayerRow{l.Starters, l.Unranked, l.Bench, l.BenchUnranked, l.TopFreeAgents, l.TopFreeAgentsByValue} {
+		add(rows)
+	}
+	for _, rows := range snap.FreeAgentsByPos {
+		add(rows)
+	}
+	return ids
+}
+
+// rowPlayerID prefers the row's own ID and falls back to a name lookup
+func rowPlayerID(row PlayerRow, ids map[string]string) string {
+	if row.PlayerID != "" {
+		return row.PlayerID
+	}
+	return ids[normalizeName(stripHTML(row.Name))]
+}
+
+// sumPlayerPoints totals a player's points over weeks [from, from+weeks), stopping at the
+// first unplayed week. Returns the total and how many weeks were counted.
+func sumPlayerPoints(points weekPointsFunc, season, scoring, pid string, from, weeks int) (float64, int) {
+	total := 0.0
+	counted := 0
+	for w := from; w < from+weeks; w++ {
+		wp, ok := points(season, w)
+		if !ok {
+			break
+		}
+		total += wp[pid].forScoring(scoring)
+		counted++
+	}
+	return total, counted
+}
+
+// runBacktest replays each snapshot through generateWaiverRecommendations and buildWeeklyActions
+// and scores every recommendation against what the displaced player went on to score
+func runBacktest(snaps []WeeklySnapshot, horizon int, points weekPointsFunc) BacktestReport {
+	if horizon <= 0 {
+		horizon = BACKTEST_DEFAULT_HORIZON
+	}
+	report := BacktestReport{Horizon: horizon, Snapshots: len(snaps)}
+
+	for _, snap := range snaps {
+		league := snap.League
+		if _, ok := points(snap.Season, snap.Week); !ok {
+			report.Skipped++ // Week not played yet
+			continue
+		}
+		ids := snapshotPlayerIDs(snap)
+		evaluate := func(model string, player, versus PlayerRow, weeks int) (BacktestOutcome, bool) {
+			pid, vid := rowPlayerID(player, ids), rowPlayerID(versus, ids)
+			if pid == "" || vid == "" {
+				return BacktestOutcome{}, false
+			}
+			pPts, n := sumPlayerPoints(points, snap.Season, league.Scoring, pid, snap.Week, weeks)
+			vPts, _ := sumPlayerPoints(points, snap.Season, league.Scoring, vid, snap.Week, n)
+			return BacktestOutcome{
+				LeagueID:     snap.LeagueID,
+				Season:       snap.Season,
+				Week:         snap.Week,
+				Model:        model,
+				Player:       stripHTML(player.Name),
+				Versus:       stripHTML(versus.Name),
+				PlayerPoints: pPts,
+				VersusPoints: vPts,
+				Weeks:        n,
+				Hit:          pPts > vPts,
+			}, true
+		}
+
+		// Waiver model: recommended add vs its paired drop
+		recs := generateWaiverRecommendations(league, snap.FreeAgentsByPos, BACKTEST_RECOMMENDATIONS, true)
+		for _, rec := range recs {
+			if !rec.NeedsDrop || rec.Drop.Player.Name == "" {
+				continue // Open roster spot: nothing displaced to compare against
+			}
+			outcome, ok := evaluate("waiver", rec.Player, rec.Drop.Player, horizon)
+			if !ok {
+				continue
+			}
+			outcome.Score = rec.Score
+			outcome.Priority = rec.Priority
+			outcome.BidPercent = rec.SuggestedBid
+			report.Outcomes = append(report.Outcomes, outcome)
+		}
+
+		// Weekly actions: swaps are judged on this week alone, waiver actions over the horizon
+		for _, action := range buildWeeklyActions(league) {
+			if action.PlayerName == "" || action.VersusName == "" {
+				continue
+			}
+			player := PlayerRow{Name: action.PlayerName}
+			versus := PlayerRow{Name: action.VersusName}
+			var outcome BacktestOutcome
+			var ok bool
+			switch action.Category {
+			case "swap":
+				outcome, ok = evaluate("action-swap", player, versus, 1)
+			case "waiver":
+				outcome, ok = evaluate("action-waiver", player, versus, horizon)
+			}
+			if ok {
+				report.Outcomes = append(report.Outcomes, outcome)
+			}
+		}
+	}
+
+	report.Models = summarizeBacktest(report.Outcomes, func(o BacktestOutcome) string { return o.Model })
+	waivers := []BacktestOutcome{}
+	for _, o := range report.Outcomes {
+		if o.Model == "waiver" {
+			waivers = append(waivers, o)
+		}
+	}
+	report.WaiverByPriority = summarizeBacktest(waivers, func(o BacktestOutcome) string { return o.Priority })
+	report.WaiverByBid = summarizeBacktest(waivers, func(o BacktestOutcome) string {
+		switch {
+		case o.BidPercent >= 15:
+			return "15%+ bid"
+		case o.BidPercent >= 5:
+			return "5-14% bid"
+		}
+		return "0-4% bid"
+	})
+	return report
+}
+
+// summarizeBacktest groups outcomes and computes hit rate and average point margin per group
+func summarizeBacktest(outcomes []BacktestOutcome, key func(BacktestOutcome) string) []BacktestStats {
+	byKey := map[string]*BacktestStats{}
+	for _, o := range outcomes {
+		k := key(o)
+		s, ok := byKey[k]
+		if !ok {
+			s = &BacktestStats{Label: k}
+			byKey[k] = s
+		}
+		s.Evaluated++
+		if o.Hit {
+			s.Hits++
+		}
+		s.AvgMargin += o.PlayerPoints - o.VersusPoints
+	}
+	stats := make([]BacktestStats, 0, len(byKey))
+	for _, s := range byKey {
+		s.HitRate = float64(s.Hits) / float64(s.Evaluated) * 100
+		s.AvgMargin /= float64(s.Evaluated)
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Label < stats[j].Label })
+	return stats
+}
+
+// formatBacktestReport renders the report for the terminal
+func formatBacktestReport(r BacktestReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Backtest: %d snapshots (%d not yet scored), %d-week waiver horizon\n", r.Snapshots, r.Skipped, r.Horizon)
+	if len(r.Outcomes) == 0 {
+		b.WriteString("\nNo recommendations could be scored yet.\n")
+		return b.String()
+	}
+	section := func(title string, stats []BacktestStats) {
+		if len(stats) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n%s:\n", title)
+		for _, s := range stats {
+			fmt.Fprintf(&b, "  %-15s %3d/%-3d hits (%5.1f%%)  avg margin %+.1f pts\n", s.Label, s.Hits, s.Evaluated, s.HitRate, s.AvgMargin)
+		}
+	}
+	section("By model", r.Models)
+	section("Waiver pickups by priority", r.WaiverByPriority)
+	section("Waiver pickups by FAAB bid", r.WaiverByBid)
+
+	misses := []BacktestOutcome{}
+	for _, o := range r.Outcomes {
+		if !o.Hit {
+			misses = append(misses, o)
+		}
+	}
+	sort.Slice(misses, func(i, j int) bool {
+		return misses[i].PlayerPoints-misses[i].VersusPoints < misses[j].PlayerPoints-misses[j].VersusPoints
+	})
+	if len(misses) > 5 {
+		misses = misses[:5]
+	}
+	if len(misses) > 0 {
+		b.WriteString("\nWorst misses:\n")
+		for _, o := range misses {
+			fmt.Fprintf(&b, "  %s wk%d %s: %s %.1f vs %s %.1f\n", o.Season, o.Week, o.Model, o.Player, o.PlayerPoints, o.Versus, o.VersusPoints)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func backtestSnapshot() WeeklySnapshot {
+	league := LeagueData{
+		LeagueID:    "L1",
+		Season:      "2025",
+		Scoring:     "PPR",
+		HasMatchups: true,
+		Starters: []PlayerRow{
+			{PlayerID: "qb1", Name: "Starter QB", Pos: "QB", RealPos: "QB", Tier: 3},
+			{PlayerID: "wr1", Name: "Starter WR", Pos: "WR", RealPos: "WR", Tier: 8},
+		},
+		Bench: []PlayerRow{
+			{PlayerID: "wr2", Name: "Bench WR", Pos: "WR", RealPos: "WR", Tier: 5},
+			{PlayerID: "rb9", Name: "Bench RB", Pos: "RB", RealPos: "RB", Tier: 12},
+		},
+		RosterStatus: RosterStatus{ActiveSlots: 4, ActivePlayers: 4, StarterSlots: []string{"QB", "WR"}, Week: 5},
+	}
+	fas := map[string][]PlayerRow{
+		"WR": {{PlayerID: "fa1", Name: "Waiver WR", Pos: "WR", Tier: 4, IsFreeAgent: true, RosterPercent: 30}},
+	}
+	league.TopFreeAgentsByValue = fas["WR"]
+	return WeeklySnapshot{LeagueID: "L1", Season: "2025", Week: 5, League: league, FreeAgentsByPos: fas}
+}
+
+func TestRunBacktest(t *testing.T) {
+	weeks := map[int]map[string]PlayerPoints{
+		5: {"fa1": {PPR: 15}, "rb9": {PPR: 2}, "wr2": {PPR: 20}, "wr1": {PPR: 5}},
+		6: {"fa1": {PPR: 12}, "rb9": {PPR: 30}},
+	}
+	points := func(season string, week int) (map[string]PlayerPoints, bool) {
+		wp, ok := weeks[week]
+		return wp, ok
+	}
+
+	report := runBacktest([]WeeklySnapshot{backtestSnapshot()}, 3, points)
+	byModel := map[string]BacktestStats{}
+	for _, s := range report.Models {
+		byModel[s.Label] = s
+	}
+	if s := byModel["action-swap"]; s.Evaluated != 1 || s.Hits != 1 {
+		t.Fatalf("expected one winning swap, got %+v (%+v)", s, report.Outcomes)
+	}
+	waiver, ok := byModel["waiver"]
+	if !ok || waiver.Evaluated != 1 || waiver.Hits != 0 {
+		t.Fatalf("expected one losing waiver pickup, got %+v (%+v)", waiver, report.Outcomes)
+	}
+	for _, o := range report.Outcomes {
+		if o.Model == "waiver" && (o.Weeks != 2 || o.PlayerPoints != 27 || o.VersusPoints != 32) {
+			t.Fatalf("expected two scored weeks for waiver outcome, got %+v", o)
+		}
+	}
+	if len(report.WaiverByPriority) != 1 || len(report.WaiverByBid) != 1 {
+		t.Fatalf("expected waiver buckets, got %+v %+v", report.WaiverByPriority, report.WaiverByBid)
+	}
+	if text := formatBacktestReport(report); !strings.Contains(text, "Worst misses") {
+		t.Fatalf("expected misses in report:\n%s", text)
+	}
+
+	unplayed := runBacktest([]WeeklySnapshot{backtestSnapshot()}, 3, func(string, int) (map[string]PlayerPoints, bool) { return nil, false })
+	if unplayed.Skipped != 1 || len(unplayed.Outcomes) != 0 {
+		t.Fatalf("expected unplayed week to be skipped, got %+v", unplayed)
+	}
+}
+
+func TestSnapshotArchiveRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	snap := backtestSnapshot()
+	if err := archiveWeeklySnapshot(dir, snap.League, snap.FreeAgentsByPos, 5); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	// The first snapshot of a week wins
+	later := snap.League
+	later.Starters = nil
+	if err := archiveWeeklySnapshot(dir, later, nil, 5); err != nil {
+		t.Fatalf("second archive failed: %v", err)
+	}
+
+	snaps, err := loadWeeklySnapshots(dir, "")
+	if err != nil || len(snaps) != 1 {
+		t.Fatalf("expected one snapshot, got %d (%v)", len(snaps), err)
+	}
+	got := snaps[0]
+	if got.Week != 5 || len(got.League.Starters) != 2 {
+		t.Fatalf("unexpected snapshot: %+v", got)
+	}
+	if tier, ok := got.League.Starters[0].Tier.(int); !ok || tier != 3 {
+		t.Fatalf("expected int tier after load, got %#v", got.League.Starters[0].Tier)
+	}
+}
+
+func TestSnapshotPathSanitizesLeagueID(t *testing.T) {
+	path := snapshotPath("/archive", "../etc/L1", "2025", 3)
+	if path != filepath.Join("/archive", ".._etc_L1", "2025-wk03.json") {
+		t.Fatalf("expected the league ID kept inside the archive, got %s", path)
+	}
+}
+
+func TestWeekPointsPathSanitizesSeason(t *testing.T) {
+	path := weekPointsPath("/archive", "../../2025", 3)
+	if path != filepath.Join("/archive", "points", ".._.._2025-wk03.json") {
+		t.Fatalf("expected the season kept inside the points dir, got %s", path)
+	}
+}
+
+func TestLoadWeekPointsArchives(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"p1":{"pts_ppr":21.5,"pts_half_ppr":18,"pts_std":14.5},"p2":{"gp":1}}`))
+	}))
+	defer server.Close()
+	p := NewSleeperProvider(server.Client())
+	p.baseURL = server.URL
+
+	dir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		points, err := loadWeekPoints(dir, "2025", 3, p)
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		if len(points) != 1 || points["p1"].forScoring("Half PPR") != 18 {
+			t.Fatalf("unexpected points: %+v", points)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected archived points on second load, got %d requests", requests)
+	}
+}
+
+func TestWeekComplete(t *testing.T) {
+	state := map[string]interface{}{"season": "2025", "week": float64(6), "season_type": "regular"}
+	if !weekComplete(state, "2025", 5) || weekComplete(state, "2025", 6) {
+		t.Fatalf("expected only earlier weeks complete")
+	}
+	if !weekComplete(state, "2024", 17) || weekComplete(state, "2026", 1) {
+		t.Fatalf("unexpected cross-season result")
+	}
+	state["season_type"] = "off"
+	if !weekComplete(state, "2025", 18) {
+		t.Fatalf("expected offseason to complete every week")
+	}
+}
@@ -49,6 +49,10 @@ func Run(args []string) int {
 		return cmdDynastyValues(ctx)
 	case "player":
 		return cmdPlayer(ctx)
+	case "backtest":
+		return cmdBacktest(ctx)
+	case "trade":
+		return cmdTrade(ctx)
 	case "test":
 		return cmdTest(ctx)
 	default:
@@ -70,6 +74,10 @@ Commands:
   tiers <format>               Fetch Boris Chen tiers (ppr, half-ppr, standard, superflex)
   dynasty-values               Fetch KTC dynasty values
   player <name>                Look up player
+  backtest [league_id] [weeks] Replay archived weekly snapshots through the waiver/action models
+  trade <league_id> <user> <give> <get> [partner_roster_id]
+                               Evaluate a trade (comma-separated players/picks, e.g. "2027 1st");
+                               a partner is required when get is empty
   test                         Run integration tests
 
 Flags:
@@ -81,5 +89,8 @@ Examples:
   sleeperPy cli user wbollock
   sleeperPy cli league 123456789 wbollock --json
   sleeperPy cli tiers ppr
+  sleeperPy cli --json backtest 123456789 4
+  sleeperPy cli trade 123456789 wbollock "Bijan Robinson" "Ja'Marr Chase,2027 2nd"
+  sleeperPy cli trade 123456789 wbollock "2027 2nd" "" 4
   sleeperPy cli test --debug`)
 }
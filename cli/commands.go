@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -15,6 +16,8 @@ type APIClient interface {
 	FetchBorisChenTiers(ctx context.Context, scoring string) (map[string][][]string, error)
 	FetchDynastyValues(ctx context.Context) (map[string]interface{}, string, error)
 	FetchPlayers(ctx context.Context) (map[string]interface{}, error)
+	RunBacktest(ctx context.Context, leagueID string, horizon int) (interface{}, string, error)
+	EvaluateTrade(ctx context.Context, leagueID, username string, partner int, give, get []string) (interface{}, string, error)
 }
 
 // Global API client instance
@@ -266,3 +269,85 @@ func cmdPlayer(ctx *Context) int {
 
 	return 0
 }
+
+func cmdBacktest(ctx *Context) int {
+	if API == nil {
+		fmt.Fprintln(os.Stderr, "Error: API client not initialized")
+		return 1
+	}
+
+	leagueID := ""
+	horizon := 0
+	if len(ctx.Args) >= 1 && ctx.Args[0] != "all" {
+		leagueID = ctx.Args[0]
+	}
+	if len(ctx.Args) >= 2 {
+		n, err := strconv.Atoi(ctx.Args[1])
+		if err != nil || n < 1 {
+			fmt.Fprintln(os.Stderr, "Usage: cli backtest [league_id|all] [weeks]")
+			return 1
+		}
+		horizon = n
+	}
+
+	report, summary, err := API.RunBacktest(context.Background(), leagueID, horizon)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running backtest: %v\n", err)
+		return 1
+	}
+
+	if ctx.JSON {
+		json.NewEncoder(os.Stdout).Encode(report)
+	} else {
+		fmt.Print(summary)
+	}
+
+	return 0
+}
+
+func cmdTrade(ctx *Context) int {
+	if len(ctx.Args) < 4 {
+		fmt.Fprintln(os.Stderr, "Usage: cli trade <league_id> <username> <give> <get> [partner_roster_id]")
+		fmt.Fprintln(os.Stderr, "  give/get are comma-separated players or picks, e.g. \"Bijan Robinson,2027 1st\"")
+		fmt.Fprintln(os.Stderr, "  partner_roster_id is required when get is empty")
+		return 1
+	}
+
+	partner := 0
+	if len(ctx.Args) > 4 {
+		var err error
+		if partner, err = strconv.Atoi(ctx.Args[4]); err != nil || partner <= 0 {
+			fmt.Fprintf(os.Stderr, "Error: invalid partner roster ID %q\n", ctx.Args[4])
+			return 1
+		}
+	}
+
+	if API == nil {
+		fmt.Fprintln(os.Stderr, "Error: API client not initialized")
+		return 1
+	}
+
+	split := func(list string) []string {
+		out := []string{}
+		for _, part := range strings.Split(list, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+		return out
+	}
+
+	report, summary, err := API.EvaluateTrade(context.Background(), ctx.Args[0], ctx.Args[1], partner, split(ctx.Args[2]), split(ctx.Args[3]))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error evaluating trade: %v\n", err)
+		return 1
+	}
+
+	if ctx.JSON {
+		json.NewEncoder(os.Stdout).Encode(report)
+	} else {
+		fmt.Print(summary)
+	}
+
+	return 0
+}
@@ -0,0 +1,210 @@
+// ABOUTME: Contention windows classifying every dynasty team as contender, fringe, retooling, or rebuilding
+// ABOUTME: Blends starter strength, record, age profile, and pick capital, and pairs complementary trade partners
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	WindowContender  = "Contender"
+	WindowFringe     = "Fringe"
+	WindowRetooling  = "Retooling"
+	WindowRebuilding = "Rebuilding"
+)
+
+// Thresholds on the 0-1 "now" and "future" scores
+const (
+	contenderNowScore = 0.65
+	fringeNowScore    = 0.40
+	rebuildFutureMin  = 0.50 // Below this a non-contender lacks the youth and picks to rebuild cleanly
+)
+
+// windowPartners lists the windows each window trades with most naturally: contenders
+// sell youth and picks for production, rebuilders do the reverse
+var windowPartners = map[string][]string{
+	WindowContender:  {WindowRebuilding, WindowRetooling},
+	WindowFringe:     {WindowRebuilding},
+	WindowRetooling:  {WindowContender},
+	WindowRebuilding: {WindowContender, WindowFringe},
+}
+
+// leaguePercentiles ranks each score against the league: 1 = best, 0 = worst, ties share a value.
+// A league with no spread scores everyone 0.5.
+func leaguePercentiles(scores []float64) []float64 {
+	n := len(scores)
+	out := make([]float64, n)
+	if n == 0 {
+		return out
+	}
+	if n == 1 {
+		out[0] = 0.5
+		return out
+	}
+	for i, s := range scores {
+		below, equal := 0, 0
+		for j, o := range scores {
+			if j == i {
+				continue
+			}
+			if o < s {
+				below++
+			} else if o == s {
+				equal++
+			}
+		}
+		out[i] = (float64(below) + float64(equal)/2) / float64(n-1)
+	}
+	return out
+}
+
+// classifyContentionWindows assigns each team a window and an explanation. "Now" blends
+// starter strength with record (all-play included); "future" blends roster youth with pick value.
+func classifyContentionWindows(rankings []PowerRanking) {
+	n := len(rankings)
+	if n == 0 {
+		return
+	}
+
+	// Unknown ages count as league average so they neither help nor hurt
+	ageSum, ageCount := 0.0, 0
+	for _, r := range rankings {
+		if r.AvgAge > 0 {
+			ageSum += r.AvgAge
+			ageCount++
+		}
+	}
+	avgAge := 0.0
+	if ageCount > 0 {
+		avgAge = ageSum / float64(ageCount)
+	}
+
+	starters := make([]float64, n)
+	record := make([]float64, n)
+	youth := make([]float64, n)
+	picks := make([]float64, n)
+	for i, r := range rankings {
+		if r.StarterTier > 0 {
+			starters[i] = -r.StarterTier // Lower tier is better
+		} else {
+			starters[i] = math.Inf(-1)
+		}
+		games := r.Wins + r.Losses + r.Ties
+		if games > 0 {
+			record[i] = (float64(r.Wins) + float64(r.Ties)/2) / float64(games)
+		}
+		if r.AllPlayRank > 0 {
+			record[i] = (record[i] + r.AllPlayPct) / 2
+		}
+		age := r.AvgAge
+		if age <= 0 {
+			age = avgAge
+		}
+		youth[i] = -age
+		picks[i] = r.PickCapital
+	}
+	starterPct := leaguePercentiles(starters)
+	recordPct := leaguePercentiles(record)
+	youthPct := leaguePercentiles(youth)
+	pickPct := leaguePercentiles(picks)
+
+	for i := range rankings {
+		now := 0.6*starterPct[i] + 0.4*recordPct[i]
+		future := 0.5*youthPct[i] + 0.5*pickPct[i]
+		var window, summary string
+		switch {
+		case now >= contenderNowScore:
+			window, summary = WindowContender, "built to win this season"
+		case now >= fringeNowScore:
+			window, summary = WindowFringe, "a move or two from contending"
+		case future >= rebuildFutureMin:
+			window, summary = WindowRebuilding, "youth and picks point to a future window"
+		default:
+			window, summary = WindowRetooling, "not winning now without the youth or picks to rebuild"
+		}
+		rankings[i].Window = window
+		rankings[i].WindowReason = fmt.Sprintf("%s: starters %s, record %s, age %s, picks %s",
+			summary,
+			leagueRankLabel(starterPct[i], n),
+			leagueRankLabel(recordPct[i], n),
+			leagueRankLabel(youthPct[i], n),
+			leagueRankLabel(pickPct[i], n))
+	}
+}
+
+// leagueRankLabel turns a percentile back into a "#3 of 12" style rank
+func leagueRankLabel(pct float64, teams int) string {
+	rank := int(math.Round((1-pct)*float64(teams-1))) + 1
+	return fmt.Sprintf("#%d of %d", rank, teams)
+}
+
+// isWindowPartner reports whether a team in theirs is a natural trade partner for a team in ours
+func isWindowPartner(ours, theirs string) bool {
+	for _, w := range windowPartners[ours] {
+		if w == theirs {
+			return true
+		}
+	}
+	return false
+}
+
+// contentionTradePartners lists the teams whose window complements the user's, best power rank first
+func contentionTradePartners(rankings []PowerRanking) []PowerRanking {
+	userWindow := ""
+	for _, r := range rankings {
+		if r.IsUserTeam {
+			userWindow = r.Window
+		}
+	}
+	partners := []PowerRanking{}
+	if userWindow == "" {
+		return partners
+	}
+	for _, r := range rankings {
+		if !r.IsUserTeam && isWindowPartner(userWindow, r.Window) {
+			partners = append(partners, r)
+		}
+	}
+	return partners
+}
+
+// annotateTradeTargetWindows tags each trade target with its window and moves targets whose
+// window complements the user's to the front, keeping the positional order otherwise
+func annotateTradeTargetWindows(targets []TradeTarget, rankings []PowerRanking) {
+	userWindow := ""
+	windowByTeam := map[string]string{}
+	for _, r := range rankings {
+		if r.IsUserTeam {
+			userWindow = r.Window
+		}
+		windowByTeam[r.TeamName] = r.Window
+	}
+	if userWindow == "" {
+		return
+	}
+	for i := range targets {
+		w := windowByTeam[targets[i].TeamName]
+		targets[i].TheirWindow = w
+		if isWindowPartner(userWindow, w) {
+			targets[i].Reason += fmt.Sprintf("; you're %s, they're %s", windowPhrase(userWindow), windowPhrase(w))
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		return isWindowPartner(userWindow, targets[i].TheirWindow) && !isWindowPartner(userWindow, targets[j].TheirWindow)
+	})
+}
+
+// windowPhrase renders a window as lowercase prose ("a contender", "rebuilding")
+func windowPhrase(window string) string {
+	switch window {
+	case WindowContender:
+		return "a contender"
+	case WindowFringe:
+		return "on the fringe"
+	}
+	return strings.ToLower(window)
+}
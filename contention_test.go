@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLeaguePercentiles(t *testing.T) {
+	got := leaguePercentiles([]float64{10, 30, 20, 20})
+	want := []float64{0, 1, 0.5, 0.5}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected percentiles: %v", got)
+		}
+	}
+	if single := leaguePercentiles([]float64{5}); single[0] != 0.5 {
+		t.Fatalf("expected a lone team at 0.5, got %v", single)
+	}
+}
+
+func TestClassifyContentionWindows(t *testing.T) {
+	rankings := []PowerRanking{
+		{TeamName: "Champ", StarterTier: 2, Wins: 9, AvgAge: 28, PickCapital: 1000},
+		{TeamName: "Bubble", StarterTier: 4, Wins: 4, Losses: 5, AvgAge: 26, PickCapital: 2000},
+		{TeamName: "Kids", StarterTier: 6, Wins: 1, Losses: 8, AvgAge: 22, PickCapital: 9000},
+		{TeamName: "Stuck", StarterTier: 7, Wins: 4, Losses: 5, AvgAge: 29, PickCapital: 500},
+		{TeamName: "Unranked", Wins: 7, Losses: 2, PickCapital: 8000}, // No lineup tiers or ages yet
+	}
+	classifyContentionWindows(rankings)
+	want := map[string]string{
+		"Champ":    WindowContender,
+		"Bubble":   WindowFringe,
+		"Kids":     WindowRebuilding,
+		"Stuck":    WindowRetooling,
+		"Unranked": WindowRebuilding,
+	}
+	for _, r := range rankings {
+		if r.Window != want[r.TeamName] {
+			t.Fatalf("%s: expected %s, got %s (%s)", r.TeamName, want[r.TeamName], r.Window, r.WindowReason)
+		}
+	}
+	if !strings.Contains(rankings[0].WindowReason, "starters #1 of 5") || !strings.Contains(rankings[2].WindowReason, "age #1 of 5") {
+		t.Fatalf("expected league ranks in explanations: %q / %q", rankings[0].WindowReason, rankings[2].WindowReason)
+	}
+}
+
+func TestContentionTradePartnersAndTargets(t *testing.T) {
+	rankings := []PowerRanking{
+		{TeamName: "Me", IsUserTeam: true, Window: WindowContender},
+		{TeamName: "Also Good", Window: WindowContender},
+		{TeamName: "Young", Window: WindowRebuilding},
+		{TeamName: "Stuck", Window: WindowRetooling},
+	}
+	partners := contentionTradePartners(rankings)
+	if len(partners) != 2 || partners[0].TeamName != "Young" || partners[1].TeamName != "Stuck" {
+		t.Fatalf("unexpected partners: %+v", partners)
+	}
+
+	targets := []TradeTarget{
+		{TeamName: "Also Good", Reason: "Has RB depth, needs WR"},
+		{TeamName: "Young", Reason: "Has WR depth, needs RB"},
+	}
+	annotateTradeTargetWindows(targets, rankings)
+	if targets[0].TeamName != "Young" || targets[0].TheirWindow != WindowRebuilding {
+		t.Fatalf("expected complementary window first: %+v", targets)
+	}
+	if !strings.Contains(targets[0].Reason, "you're a contender, they're rebuilding") || strings.Contains(targets[1].Reason, "you're") {
+		t.Fatalf("unexpected reasons: %q / %q", targets[0].Reason, targets[1].Reason)
+	}
+}
+
+func TestDetermineStrategyFollowsWindow(t *testing.T) {
+	league := func(window string, age float64) LeagueData {
+		return LeagueData{IsDynasty: true, PowerRankings: []PowerRanking{{IsUserTeam: true, Window: window, AvgAge: age}}}
+	}
+	cases := []struct {
+		window string
+		age    float64
+		want   string
+	}{
+		{WindowContender, 28, "Win Now"},
+		{WindowContender, 25, "Contending"},
+		{WindowFringe, 24, "Build"},
+		{WindowFringe, 27, "Retool"},
+		{WindowRetooling, 29, "Retool"},
+		{WindowRebuilding, 23, "Rebuild"},
+	}
+	for _, c := range cases {
+		if got := determineStrategy(league(c.window, c.age)); got != c.want {
+			t.Fatalf("%s age %.0f: expected %s, got %s", c.window, c.age, c.want, got)
+		}
+	}
+}
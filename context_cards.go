@@ -6,6 +6,7 @@ package main
 import (
 	"fmt"
 	"sort"
+	"strings"
 )
 
 func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float64) []ContextCard {
@@ -29,12 +30,16 @@ func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float6
 			color = "#3b82f6" // blue - top 3
 		}
 
-		cards = append(cards, ContextCard{
+		card := ContextCard{
 			Title: "Roster Value",
 			Value: fmt.Sprintf("#%d%s of %d", valueRank, rankSuffix, league.LeagueSize),
 			Icon:  "💎",
 			Color: color,
-		})
+		}
+		if league.DraftPickValue > 0 {
+			card.Trend = fmt.Sprintf("Picks: %d%% of value", league.DraftPickValue*100/userRosterValue)
+		}
+		cards = append(cards, card)
 	}
 
 	// 2. Age Rank Card (dynasty only)
@@ -125,8 +130,11 @@ func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float6
 		})
 	}
 
-	// 4. Positional Scarcity Card (dynasty only)
-	if league.IsDynasty {
+	// 4. Positional Balance Card: league-relative needs from the position matrix,
+	// falling back to the user's own value split in dynasty leagues
+	if row, ok := userPositionMatrixRow(league.PositionMatrix); ok {
+		cards = append(cards, positionMatrixCard(row, len(league.PositionMatrix.Teams)))
+	} else if league.IsDynasty {
 		pb := league.PositionalBreakdown
 		total := pb.QB + pb.RB + pb.WR + pb.TE
 		if total > 0 {
@@ -175,9 +183,9 @@ func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float6
 		// Parse record from power rankings
 		for _, pr := range league.PowerRankings {
 			if pr.IsUserTeam {
-				totalGames := pr.Wins + pr.Losses
+				totalGames := pr.Wins + pr.Losses + pr.Ties
 				if totalGames > 0 {
-					winPct := float64(pr.Wins) / float64(totalGames)
+					winPct := (float64(pr.Wins) + float64(pr.Ties)/2) / float64(totalGames)
 
 					status := "In Hunt"
 					color := "#f59e0b" // orange
@@ -192,8 +200,8 @@ func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float6
 
 					cards = append(cards, ContextCard{
 						Title: "Season Status",
-						Value: fmt.Sprintf("%d-%d", pr.Wins, pr.Losses),
-						Trend: status,
+						Value: formatRecord(pr.Wins, pr.Losses, pr.Ties),
+						Trend: fmt.Sprintf("%s · %d%s place", status, pr.StandingRank, getRankSuffix(pr.StandingRank)),
 						Icon:  "🏆",
 						Color: color,
 					})
@@ -206,6 +214,26 @@ func buildContextCards(league LeagueData, userRosterValue int, userAvgAge float6
 	return cards
 }
 
+// positionMatrixCard summarizes the user's weakest position and spare depth against the league
+func positionMatrixCard(row TeamPositionRow, teams int) ContextCard {
+	card := ContextCard{Title: "Roster Balance", Value: "Balanced", Icon: "⚖️", Color: "#10b981"}
+	var weakest *PositionStrength
+	for i := range row.Positions {
+		ps := &row.Positions[i]
+		if ps.Status == "Need" && (weakest == nil || ps.Rank > weakest.Rank) {
+			weakest = ps
+		}
+	}
+	if weakest != nil {
+		card.Value = fmt.Sprintf("%s need (#%d of %d)", weakest.Position, weakest.Rank, teams)
+		card.Color = "#ef4444"
+	}
+	if len(row.Surplus) > 0 {
+		card.Trend = "Surplus: " + strings.Join(row.Surplus, ", ")
+	}
+	return card
+}
+
 func getRankSuffix(rank int) string {
 	if rank == 1 {
 		return "st"
@@ -0,0 +1,535 @@
+// ABOUTME: Rookie draft order projection under the league's draft order rules
+// ABOUTME: Simulates the rest of the season and bracket to give each original owner's pick a slot distribution
+
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DraftOrderReverseStandings = "reverse_standings" // Worst record picks first
+	DraftOrderMaxPF            = "max_pf"            // Fewest potential points picks first
+	DraftOrderPlayoff          = "playoff"           // Non-playoff teams by record, then playoff teams by bracket finish
+	DraftOrderLottery          = "lottery"           // Weighted lottery among non-playoff teams, then playoff teams by record
+)
+
+const (
+	DRAFT_ORDER_SIMULATIONS = 2000
+	DEFAULT_POTENTIAL_RATIO = 1.15 // Potential points per scored point when Sleeper has no max PF yet
+)
+
+// draftOrderMethodLabels describe each method for display
+var draftOrderMethodLabels = map[string]string{
+	DraftOrderReverseStandings: "reverse standings",
+	DraftOrderMaxPF:            "reverse max points for",
+	DraftOrderPlayoff:          "playoff bracket finish",
+	DraftOrderLottery:          "non-playoff lottery",
+}
+
+// DraftOrderOption is one choice in a draft order selector
+type DraftOrderOption struct {
+	Value string
+	Label string
+}
+
+// draftOrderOptions lists every method for the draft order selectors
+func draftOrderOptions() []DraftOrderOption {
+	out := []DraftOrderOption{}
+	for _, m := range []string{DraftOrderReverseStandings, DraftOrderMaxPF, DraftOrderPlayoff, DraftOrderLottery} {
+		out = append(out, DraftOrderOption{Value: m, Label: draftOrderMethodLabels[m]})
+	}
+	return out
+}
+
+// parseDraftOrderMethod reads a draft_order request parameter. Sleeper has no league or draft setting
+// for how the rookie order is decided, so each page that projects it takes the method per request.
+func parseDraftOrderMethod(requested string) string {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	if _, ok := draftOrderMethodLabels[requested]; ok {
+		return requested
+	}
+	if requested != "" {
+		debugLog("[DEBUG] Ignoring unknown draft order method %q", requested)
+	}
+	return DraftOrderReverseStandings
+}
+
+// draftOrderProjection is the chance of each slot for every original owner's picks
+type draftOrderProjection struct {
+	Method   string
+	Source   string            // How the order was settled: Sleeper draft order, final standings, or simulation
+	Teams    int               // League size
+	SlotOdds map[int][]float64 // Original roster ID -> percent chance of each slot (index 0 = slot 1)
+}
+
+// likelySlot is the roster's most probable slot (0 when unknown)
+func (p *draftOrderProjection) likelySlot(rosterID int) int {
+	if p == nil {
+		return 0
+	}
+	best, bestPct := 0, 0.0
+	for i, pct := range p.SlotOdds[rosterID] {
+		if pct > bestPct {
+			best, bestPct = i+1, pct
+		}
+	}
+	return best
+}
+
+// likelySlots maps every roster to its most probable slot
+func (p *draftOrderProjection) likelySlots() map[int]int {
+	slots := map[int]int{}
+	if p == nil {
+		return slots
+	}
+	for rosterID := range p.SlotOdds {
+		slots[rosterID] = p.likelySlot(rosterID)
+	}
+	return slots
+}
+
+// distribution lists the roster's possible slots in slot order
+func (p *draftOrderProjection) distribution(rosterID int) []SlotProbability {
+	if p == nil {
+		return nil
+	}
+	out := []SlotProbability{}
+	for i, pct := range p.SlotOdds[rosterID] {
+		if pct > 0 {
+			out = append(out, SlotProbability{Slot: i + 1, Pct: pct})
+		}
+	}
+	return out
+}
+
+// potentialPoints tracks a roster's max points for and how it grows with points scored
+type potentialPoints struct {
+	Current float64
+	Ratio   float64
+}
+
+// rosterPotentialPoints reads Sleeper's max points for (ppts) for each roster
+func rosterPotentialPoints(rosters []map[string]interface{}) map[int]potentialPoints {
+	out := map[int]potentialPoints{}
+	for _, r := range rosters {
+		rid, _ := r["roster_id"].(float64)
+		pf := rosterPointsFor(r)
+		ppts := rosterSettingFloat(r, "ppts") + rosterSettingFloat(r, "ppts_decimal")/100
+		pp := potentialPoints{Current: ppts, Ratio: DEFAULT_POTENTIAL_RATIO}
+		if ppts > 0 && pf > 0 {
+			pp.Ratio = ppts / pf
+		} else if ppts == 0 {
+			pp.Current = pf * DEFAULT_POTENTIAL_RATIO
+		}
+		out[int(rid)] = pp
+	}
+	return out
+}
+
+// worstFirst orders teams by record, worst first, with fewer points-for breaking ties
+func worstFirst(teams []simTeam) []simTeam {
+	out := append([]simTeam{}, teams...)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Wins != out[j].Wins {
+			return out[i].Wins < out[j].Wins
+		}
+		if out[i].Ties != out[j].Ties {
+			return out[i].Ties < out[j].Ties
+		}
+		return out[i].PointsFor < out[j].PointsFor
+	})
+	return out
+}
+
+// bracketSource is the earlier game whose winner (or loser) fills a bracket slot
+type bracketSource struct {
+	Match int
+	Loser bool
+}
+
+// bracketMatch is one game of Sleeper's winners bracket
+type bracketMatch struct {
+	Round, Match  int
+	Team1, Team2  int // Roster IDs, 0 until the feeding game is decided
+	From1, From2  bracketSource
+	Winner, Loser int // 0 until played
+	Place         int // Placement the winner earns (1 = title game, 3 = third place game)
+}
+
+// consolation reports whether the game is fed by losers, i.e. off the championship path
+func (m bracketMatch) consolation() bool {
+	return m.From1.Loser || m.From2.Loser
+}
+
+// playoffBracket is the league's actual winners bracket, in round order
+type playoffBracket struct {
+	matches []bracketMatch
+	teams   map[int]bool
+}
+
+// parsePlayoffBracket reads Sleeper's winners bracket. ok is false until the first round is set.
+func parsePlayoffBracket(raw []map[string]interface{}) (*playoffBracket, bool) {
+	num := func(m map[string]interface{}, key string) int {
+		v, _ := m[key].(float64)
+		return int(v)
+	}
+	source := func(m map[string]interface{}, key string) bracketSource {
+		from, _ := m[key].(map[string]interface{})
+		if l, ok := from["l"].(float64); ok {
+			return bracketSource{Match: int(l), Loser: true}
+		}
+		w, _ := from["w"].(float64)
+		return bracketSource{Match: int(w)}
+	}
+	b := &playoffBracket{teams: map[int]bool{}}
+	for _, m := range raw {
+		b.matches = append(b.matches, bracketMatch{
+			Round: num(m, "r"), Match: num(m, "m"),
+			Team1: num(m, "t1"), Team2: num(m, "t2"),
+			From1: source(m, "t1_from"), From2: source(m, "t2_from"),
+			Winner: num(m, "w"), Loser: num(m, "l"), Place: num(m, "p"),
+		})
+	}
+	sort.SliceStable(b.matches, func(i, j int) bool {
+		if b.matches[i].Round != b.matches[j].Round {
+			return b.matches[i].Round < b.matches[j].Round
+		}
+		return b.matches[i].Match < b.matches[j].Match
+	})
+	for _, m := range b.matches {
+		if m.consolation() {
+			continue
+		}
+		if m.Round == 1 && (m.Team1 == 0 || m.Team2 == 0) {
+			return nil, false
+		}
+		for _, id := range []int{m.Team1, m.Team2} {
+			if id != 0 {
+				b.teams[id] = true
+			}
+		}
+	}
+	return b, len(b.teams) >= 2
+}
+
+// unplayed counts the bracket games without a result
+func (b *playoffBracket) unplayed() int {
+	n := 0
+	for _, m := range b.matches {
+		if m.Winner == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// play fills in the unplayed games and returns the bracket's teams in finishing order, champion first.
+// Teams knocked out in the same round are split by their placement game when one was played, then by seed.
+func (b *playoffBracket) play(sim *seasonSim, seeds []simTeam) []simTeam {
+	byRoster := make(map[int]simTeam, len(seeds))
+	seedOf := make(map[int]int, len(seeds))
+	playoff := []simTeam{}
+	for i, t := range seeds {
+		byRoster[t.RosterID] = t
+		seedOf[t.RosterID] = i
+		if b.teams[t.RosterID] {
+			playoff = append(playoff, t)
+		}
+	}
+	winners, losers := map[int]int{}, map[int]int{}
+	team := func(id int, from bracketSource) int {
+		if id != 0 || from.Match == 0 {
+			return id
+		}
+		if from.Loser {
+			return losers[from.Match]
+		}
+		return winners[from.Match]
+	}
+	outRound := map[int]int{} // Roster -> round knocked off the championship path
+	place := map[int]int{}
+	for _, m := range b.matches {
+		w, l := m.Winner, m.Loser
+		if w == 0 {
+			t1, t2 := team(m.Team1, m.From1), team(m.Team2, m.From2)
+			if t1 == 0 || t2 == 0 {
+				continue
+			}
+			w, l = t1, t2
+			if sim.sample(byRoster[t2]) > sim.sample(byRoster[t1]) {
+				w, l = t2, t1
+			}
+		}
+		winners[m.Match], losers[m.Match] = w, l
+		if m.Place > 0 {
+			place[w], place[l] = m.Place, m.Place+1
+		}
+		if !m.consolation() {
+			outRound[l] = m.Round
+		}
+	}
+
+	champion := len(b.matches) + 1
+	rank := func(t simTeam) (int, int) {
+		r, ok := outRound[t.RosterID]
+		if !ok {
+			r = champion
+		}
+		p, ok := place[t.RosterID]
+		if !ok {
+			p = len(seeds) + 1
+		}
+		return r, p
+	}
+	sort.SliceStable(playoff, func(i, j int) bool {
+		ri, pi := rank(playoff[i])
+		rj, pj := rank(playoff[j])
+		if ri != rj {
+			return ri > rj
+		}
+		if pi != pj {
+			return pi < pj
+		}
+		return seedOf[playoff[i].RosterID] < seedOf[playoff[j].RosterID]
+	})
+	return playoff
+}
+
+// seasonDraftOrder turns one simulated final season into a draft order (roster IDs, slot 1 first).
+// A set playoff bracket decides the playoff teams and their finish for the playoff method.
+func seasonDraftOrder(method string, start, season []simTeam, sim *seasonSim, playoffTeams int, potential map[int]potentialPoints, bracket *playoffBracket, rng *rand.Rand) []int {
+	var order []simTeam
+	switch method {
+	case DraftOrderMaxPF:
+		startPF := make(map[int]float64, len(start))
+		for _, t := range start {
+			startPF[t.RosterID] = t.PointsFor
+		}
+		maxPF := func(t simTeam) float64 {
+			pp := potential[t.RosterID]
+			return pp.Current + (t.PointsFor-startPF[t.RosterID])*pp.Ratio
+		}
+		order = append([]simTeam{}, season...)
+		sort.SliceStable(order, func(i, j int) bool { return maxPF(order[i]) < maxPF(order[j]) })
+	case DraftOrderPlayoff, DraftOrderLottery:
+		seeds := seedTeams(season)
+		playoff := seeds[:playoffTeams]
+		rest := worstFirst(seeds[playoffTeams:])
+		if method == DraftOrderLottery {
+			rest = lotteryDraw(rest, rng)
+			order = append(rest, worstFirst(playoff)...)
+			break
+		}
+		var finish []simTeam
+		if bracket != nil {
+			finish = bracket.play(sim, seeds)
+			rest = []simTeam{}
+			for _, t := range seeds {
+				if !bracket.teams[t.RosterID] {
+					rest = append(rest, t)
+				}
+			}
+			rest = worstFirst(rest)
+		} else {
+			finish = sim.bracket(playoff, playoffByes(playoffTeams))
+		}
+		order = rest
+		for i := len(finish) - 1; i >= 0; i-- {
+			order = append(order, finish[i])
+		}
+	default:
+		order = worstFirst(season)
+	}
+	ids := make([]int, len(order))
+	for i, t := range order {
+		ids[i] = t.RosterID
+	}
+	return ids
+}
+
+// lotteryDraw orders lottery teams by weighted draw: the worst team holds the most balls,
+// one fewer for each team above it
+func lotteryDraw(teams []simTeam, rng *rand.Rand) []simTeam {
+	left := append([]simTeam{}, teams...)
+	weights := make([]int, len(left))
+	total := 0
+	for i := range left {
+		weights[i] = len(left) - i
+		total += weights[i]
+	}
+	drawn := make([]simTeam, 0, len(left))
+	for len(left) > 0 {
+		ball := rng.Intn(total)
+		i := 0
+		for ball >= weights[i] {
+			ball -= weights[i]
+			i++
+		}
+		drawn = append(drawn, left[i])
+		total -= weights[i]
+		left = append(left[:i], left[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	return drawn
+}
+
+// simulateDraftOrder plays out the season sims times and tallies each roster's draft slot
+func simulateDraftOrder(teams []simTeam, schedule []scheduledGame, playoffTeams int, medianGames bool, method string, potential map[int]potentialPoints, bracket *playoffBracket, sims int, rng *rand.Rand) map[int][]float64 {
+	if playoffTeams > len(teams) {
+		playoffTeams = len(teams)
+	}
+	counts := make(map[int][]int, len(teams))
+	for _, t := range teams {
+		counts[t.RosterID] = make([]int, len(teams))
+	}
+	sim := newSeasonSim(teams, schedule, medianGames, rng)
+	for n := 0; n < sims; n++ {
+		season := sim.play()
+		for slot, rosterID := range seasonDraftOrder(method, teams, season, sim, playoffTeams, potential, bracket, rng) {
+			counts[rosterID][slot]++
+		}
+	}
+	odds := make(map[int][]float64, len(counts))
+	for rosterID, c := range counts {
+		odds[rosterID] = make([]float64, len(c))
+		for i, n := range c {
+			odds[rosterID][i] = pct(n, sims)
+		}
+	}
+	return odds
+}
+
+// sleeperDraftOrder returns the slots a commissioner has already set on the year's Sleeper draft
+func sleeperDraftOrder(drafts []map[string]interface{}, year, teams int) (map[int][]float64, bool) {
+	for _, d := range drafts {
+		if season, _ := d["season"].(string); season != strconv.Itoa(year) {
+			continue
+		}
+		if order, ok := d["draft_order"].(map[string]interface{}); !ok || len(order) == 0 {
+			continue
+		}
+		slots, ok := d["slot_to_roster_id"].(map[string]interface{})
+		if !ok || len(slots) != teams {
+			continue
+		}
+		odds := make(map[int][]float64, teams)
+		for slot, rid := range slots {
+			s, err := strconv.Atoi(slot)
+			v, ok := rid.(float64)
+			if err != nil || !ok || s < 1 || s > teams {
+				return nil, false
+			}
+			odds[int(v)] = make([]float64, teams)
+			odds[int(v)][s-1] = 100
+		}
+		if len(odds) == teams {
+			return odds, true
+		}
+	}
+	return nil, false
+}
+
+// projectDraftOrder projects the year's rookie draft order. A Sleeper draft with its order set wins;
+// otherwise the remaining regular season (and bracket, for playoff methods) is simulated under method.
+func projectDraftOrder(league map[string]interface{}, leagueID, method string, week int, inSeason bool, rosters []map[string]interface{}, standings []StandingsEntry, year int) *draftOrderProjection {
+	if len(standings) == 0 {
+		return nil
+	}
+	proj := &draftOrderProjection{Method: method, Teams: len(standings)}
+
+	drafts, err := appProvider.FetchLeagueDrafts(leagueID)
+	if err != nil {
+		debugLog("[DEBUG] Could not fetch drafts for draft order: %v", err)
+	}
+	if odds, ok := sleeperDraftOrder(drafts, year, proj.Teams); ok {
+		proj.SlotOdds, proj.Source = odds, "Sleeper draft order"
+		return proj
+	}
+
+	var schedule []scheduledGame
+	if inSeason && week >= 1 {
+		weeks := []int{}
+		for w := week; w < leaguePlayoffWeekStart(league); w++ {
+			weeks = append(weeks, w)
+		}
+		if len(weeks) > 0 {
+			schedule = buildRemainingSchedule(fetchMatchupsForWeeks(leagueID, weeks))
+		}
+	}
+	// Once the regular season is over, the playoff method follows the league's actual bracket
+	var bracket *playoffBracket
+	if len(schedule) == 0 && proj.Method == DraftOrderPlayoff {
+		bracket = leaguePlayoffBracket(leagueID, standings)
+	}
+	switch {
+	case len(schedule) > 0:
+		proj.Source = "simulated rest of season"
+	case bracket != nil && bracket.unplayed() == 0:
+		proj.Source = "final standings, playoff results"
+	case bracket != nil:
+		proj.Source = "final standings, playoff results with unplayed games simulated"
+	case proj.Method == DraftOrderPlayoff:
+		proj.Source = "final standings, simulated bracket"
+	case proj.Method == DraftOrderLottery:
+		proj.Source = "final standings, simulated lottery"
+	default:
+		proj.Source = "final standings"
+	}
+
+	// Seed from the league so projections are stable between page loads
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s:draft:%d:%d", leagueID, year, week)))
+	rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	medianGames := hasMedianGames(league)
+	teams := buildSimTeams(standings, nil, medianGames)
+	proj.SlotOdds = simulateDraftOrder(teams, schedule, leaguePlayoffTeams(league), medianGames, proj.Method, rosterPotentialPoints(rosters), bracket, DRAFT_ORDER_SIMULATIONS, rng)
+	debugLog("[DEBUG] Projected %d draft order by %s from %s", year, proj.Method, proj.Source)
+	return proj
+}
+
+// leaguePlayoffBracket fetches the league's winners bracket; nil until Sleeper has set it for these teams
+func leaguePlayoffBracket(leagueID string, standings []StandingsEntry) *playoffBracket {
+	raw, err := appProvider.FetchLeagueBracket(leagueID, "winners")
+	if err != nil {
+		debugLog("[DEBUG] Could not fetch playoff bracket for draft order: %v", err)
+		return nil
+	}
+	bracket, ok := parsePlayoffBracket(raw)
+	if !ok {
+		return nil
+	}
+	known := make(map[int]bool, len(standings))
+	for _, e := range standings {
+		known[e.RosterID] = true
+	}
+	for id := range bracket.teams {
+		if !known[id] {
+			return nil
+		}
+	}
+	return bracket
+}
+
+// upcomingDraftOrder projects the league's next rookie draft under method as of the current NFL week
+func upcomingDraftOrder(league map[string]interface{}, leagueID, method string, rosters []map[string]interface{}, standings []StandingsEntry) *draftOrderProjection {
+	year := upcomingDraftYear(league)
+	if year == 0 {
+		year = time.Now().Year()
+	}
+	week := 0
+	if state, err := appProvider.FetchNFLState(); err == nil {
+		if w, ok := state["week"].(float64); ok {
+			week = int(w)
+		}
+	}
+	status, _ := league["status"].(string)
+	return projectDraftOrder(league, leagueID, method, week, status == "in_season", rosters, standings, year)
+}
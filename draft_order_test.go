@@ -0,0 +1,165 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func draftOrderTestTeams() []simTeam {
+	return []simTeam{
+		{StandingsEntry: StandingsEntry{RosterID: 1, Wins: 10, Losses: 3, PointsFor: 1500}, MeanScore: 125},
+		{StandingsEntry: StandingsEntry{RosterID: 2, Wins: 8, Losses: 5, PointsFor: 1450}, MeanScore: 115},
+		{StandingsEntry: StandingsEntry{RosterID: 3, Wins: 5, Losses: 8, PointsFor: 1400}, MeanScore: 110},
+		{StandingsEntry: StandingsEntry{RosterID: 4, Wins: 3, Losses: 10, PointsFor: 1200}, MeanScore: 100},
+	}
+}
+
+func TestParseDraftOrderMethod(t *testing.T) {
+	if got := parseDraftOrderMethod(""); got != DraftOrderReverseStandings {
+		t.Fatalf("expected reverse standings by default, got %s", got)
+	}
+	if got := parseDraftOrderMethod(" Max_PF "); got != DraftOrderMaxPF {
+		t.Fatalf("expected the requested method, got %s", got)
+	}
+	if got := parseDraftOrderMethod("bogus"); got != DraftOrderReverseStandings {
+		t.Fatalf("expected an invalid method to fall back to the default, got %s", got)
+	}
+}
+
+func TestSimulateDraftOrderFinalStandings(t *testing.T) {
+	teams := draftOrderTestTeams()
+	odds := simulateDraftOrder(teams, nil, 2, false, DraftOrderReverseStandings, nil, nil, 50, rand.New(rand.NewSource(1)))
+	for rosterID, slot := range map[int]int{4: 1, 3: 2, 2: 3, 1: 4} {
+		if odds[rosterID][slot-1] != 100 {
+			t.Fatalf("expected roster %d locked into slot %d, got %v", rosterID, slot, odds[rosterID])
+		}
+	}
+
+	// Max PF: the best record with the lowest potential points picks first
+	potential := map[int]potentialPoints{
+		1: {Current: 1600, Ratio: 1.1}, 2: {Current: 1800, Ratio: 1.1},
+		3: {Current: 1700, Ratio: 1.1}, 4: {Current: 1650, Ratio: 1.1},
+	}
+	odds = simulateDraftOrder(teams, nil, 2, false, DraftOrderMaxPF, potential, nil, 50, rand.New(rand.NewSource(1)))
+	if odds[1][0] != 100 || odds[2][3] != 100 {
+		t.Fatalf("expected max PF to set the order, got %v", odds)
+	}
+}
+
+func TestSimulateDraftOrderPlayoffAndLottery(t *testing.T) {
+	teams := draftOrderTestTeams()
+	rng := rand.New(rand.NewSource(3))
+
+	playoff := simulateDraftOrder(teams, nil, 2, false, DraftOrderPlayoff, nil, nil, 400, rng)
+	if playoff[4][0] != 100 || playoff[3][1] != 100 {
+		t.Fatalf("expected non-playoff teams to pick first by record, got %v", playoff)
+	}
+	if sum := playoff[1][2] + playoff[1][3]; sum < 99.9 || sum > 100.1 || playoff[1][2] == 0 || playoff[1][3] == 0 {
+		t.Fatalf("expected the bracket to decide slots 3 and 4, got %v", playoff[1])
+	}
+	if playoff[1][3] <= playoff[2][3] {
+		t.Fatalf("expected the stronger team to win the title (last pick) more often: %v vs %v", playoff[1], playoff[2])
+	}
+
+	lottery := simulateDraftOrder(teams, nil, 2, false, DraftOrderLottery, nil, nil, 2000, rng)
+	if lottery[4][0] <= lottery[3][0] || lottery[4][0] == 100 {
+		t.Fatalf("expected the worst team to win the lottery more often but not always, got %v / %v", lottery[4], lottery[3])
+	}
+	if lottery[2][2] != 100 || lottery[1][3] != 100 {
+		t.Fatalf("expected playoff teams to pick after the lottery by record, got %v", lottery)
+	}
+}
+
+func TestSimulateDraftOrderRestOfSeason(t *testing.T) {
+	teams := draftOrderTestTeams()
+	teams[2].Wins, teams[2].Losses = 4, 9 // One game clear of last place, with fewer points
+	teams[2].PointsFor, teams[3].PointsFor = 1390, 1400
+	schedule := []scheduledGame{{Week: 14, Home: 3, Away: 4}, {Week: 14, Home: 1, Away: 2}}
+	odds := simulateDraftOrder(teams, schedule, 2, false, DraftOrderReverseStandings, nil, nil, 500, rand.New(rand.NewSource(5)))
+	if odds[4][0] == 100 || odds[4][0] == 0 || odds[4][0]+odds[3][0] < 99.9 {
+		t.Fatalf("expected the last-place race to split slot 1, got %v / %v", odds[4], odds[3])
+	}
+}
+
+func TestSleeperDraftOrder(t *testing.T) {
+	drafts := []map[string]interface{}{
+		{"season": "2026", "draft_order": map[string]interface{}{"u1": float64(2)}, "slot_to_roster_id": map[string]interface{}{"1": float64(2), "2": float64(1)}},
+		{"season": "2027", "draft_order": nil, "slot_to_roster_id": map[string]interface{}{"1": float64(1), "2": float64(2)}},
+	}
+	odds, ok := sleeperDraftOrder(drafts, 2026, 2)
+	if !ok || odds[2][0] != 100 || odds[1][1] != 100 {
+		t.Fatalf("expected the set order to be certain, got %v", odds)
+	}
+	if _, ok := sleeperDraftOrder(drafts, 2027, 2); ok {
+		t.Fatalf("expected an unset order to be ignored")
+	}
+}
+
+func TestProjectedPicksCarrySlotOdds(t *testing.T) {
+	order := &draftOrderProjection{Teams: 3, SlotOdds: map[int][]float64{
+		1: {0, 25, 75},
+		2: {60, 40, 0},
+		3: {40, 35, 25},
+	}}
+	teamAges := []TeamAgeData{
+		{TeamName: "Mine", RosterID: 1, Rank: 1},
+		{TeamName: "Rival", RosterID: 2, Rank: 3},
+		{TeamName: "Other", RosterID: 3, Rank: 2},
+	}
+	draftPicks := []DraftPick{
+		{Year: 2027, Round: 1, OwnerName: "You", RosterID: 1, IsYours: true},
+		{Year: 2027, Round: 1, OwnerName: "You", OriginalName: "Rival", RosterID: 1, IsYours: true},
+	}
+	projected := calculateProjectedDraftPicks(draftPicks, teamAges, 2027, order)
+	if len(projected) != 2 || projected[0].OriginalOwner != "Rival" || projected[0].ProjectedPosition != 1 || projected[1].ProjectedPosition != 3 {
+		t.Fatalf("expected the most likely slots, got %+v", projected)
+	}
+	if len(projected[0].SlotOdds) != 2 || projected[0].SlotOdds[1].Slot != 2 || projected[0].SlotOdds[1].Pct != 40 {
+		t.Fatalf("expected the slot distribution, got %+v", projected[0].SlotOdds)
+	}
+	if slots := order.likelySlots(); slots[3] != 1 || slots[1] != 3 {
+		t.Fatalf("unexpected likely slots: %v", slots)
+	}
+
+	v := newPickValuer(nil, false, 3, 2027)
+	valueDraftPicks(draftPicks, projected, v)
+	want := int(0.6*float64(v.value(2027, 1, 1)) + 0.4*float64(v.value(2027, 1, 2)) + 0.5)
+	if projected[0].Value != want || draftPicks[1].Value != want {
+		t.Fatalf("expected the probability-weighted value %d, got %d / %d", want, projected[0].Value, draftPicks[1].Value)
+	}
+}
+
+func TestSimulateDraftOrderFollowsPlayoffBracket(t *testing.T) {
+	from := func(side string, m int) map[string]interface{} {
+		return map[string]interface{}{side: float64(m)}
+	}
+	raw := []map[string]interface{}{
+		{"r": float64(1), "m": float64(1), "t1": float64(1), "t2": float64(4), "w": float64(4), "l": float64(1)},
+		{"r": float64(1), "m": float64(2), "t1": float64(2), "t2": float64(3)},
+		{"r": float64(2), "m": float64(3), "t1_from": from("w", 1), "t2_from": from("w", 2), "p": float64(1)},
+		{"r": float64(2), "m": float64(4), "t1_from": from("l", 1), "t2_from": from("l", 2), "p": float64(3)},
+	}
+	bracket, ok := parsePlayoffBracket(raw)
+	if !ok || len(bracket.teams) != 4 || bracket.unplayed() != 3 {
+		t.Fatalf("expected a four-team bracket with three games left, got %+v", bracket)
+	}
+	odds := simulateDraftOrder(draftOrderTestTeams(), nil, 2, false, DraftOrderPlayoff, nil, bracket, 400, rand.New(rand.NewSource(7)))
+	if odds[1][2] != 0 || odds[1][3] != 0 || odds[4][0] != 0 || odds[4][1] != 0 {
+		t.Fatalf("expected the first-round upset to stand, got %v", odds)
+	}
+
+	raw[1]["w"], raw[1]["l"] = float64(3), float64(2)
+	raw[2]["w"], raw[2]["l"] = float64(4), float64(3)
+	raw[3]["w"], raw[3]["l"] = float64(2), float64(1)
+	bracket, _ = parsePlayoffBracket(raw)
+	odds = simulateDraftOrder(draftOrderTestTeams(), nil, 2, false, DraftOrderPlayoff, nil, bracket, 50, rand.New(rand.NewSource(7)))
+	for rosterID, slot := range map[int]int{1: 1, 2: 2, 3: 3, 4: 4} {
+		if odds[rosterID][slot-1] != 100 {
+			t.Fatalf("expected roster %d to pick %d from the final placements, got %v", rosterID, slot, odds[rosterID])
+		}
+	}
+
+	if _, ok := parsePlayoffBracket([]map[string]interface{}{{"r": float64(1), "m": float64(1)}}); ok {
+		t.Fatalf("expected a bracket without first-round teams to be ignored")
+	}
+}
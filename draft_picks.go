@@ -28,6 +28,91 @@ func buildDraftPicks(
 		}
 	}
 
+	pickOwnership := buildPickOwnership(tradedPicks, rosters, numRounds, userRosterID, currentYear, debugf)
+
+	// Extract user's picks
+	debugf("[DEBUG] ===== EXTRACTING USER PICKS =====")
+	debugf("[DEBUG] Searching for picks owned by roster %d", userRosterID)
+	debugf("[DEBUG] Total picks in ownership map: %d", len(pickOwnership))
+
+	draftPicks := make([]DraftPick, 0)
+	for key, ownerRosterID := range pickOwnership {
+		if ownerRosterID != userRosterID {
+			debugf("[DEBUG] Skipping pick %s: owned by roster %d (not user)", key, ownerRosterID)
+			continue
+		}
+
+		parts := strings.Split(key, "-")
+		if len(parts) != 3 {
+			debugf("[DEBUG] Invalid pick key format: %s", key)
+			continue
+		}
+		year, _ := strconv.Atoi(parts[0])
+		round, _ := strconv.Atoi(parts[1])
+		originalRosterID, _ := strconv.Atoi(parts[2])
+
+		ownerName := "You"
+		originalName := ""
+
+		if originalRosterID != userRosterID {
+			// User acquired this pick from another team
+			if origOwner, exists := rosterOwners[originalRosterID]; exists {
+				originalName = origOwner
+			} else {
+				originalName = fmt.Sprintf("Team %d", originalRosterID)
+			}
+			debugf("[DEBUG] ✓ Pick %d Round %d: ACQUIRED from roster %d (%s)", year, round, originalRosterID, originalName)
+		} else {
+			debugf("[DEBUG] ✓ Pick %d Round %d: YOUR ORIGINAL pick", year, round)
+		}
+
+		draftPicks = append(draftPicks, DraftPick{
+			Round:        round,
+			Year:         year,
+			OwnerName:    ownerName,
+			OriginalName: originalName,
+			RosterID:     userRosterID,
+			IsYours:      true,
+		})
+	}
+
+	debugf("[DEBUG] ===================================")
+	debugf("[DEBUG] FINAL RESULT: User has %d draft picks total", len(draftPicks))
+
+	sort.Slice(draftPicks, func(i, j int) bool {
+		if draftPicks[i].Year != draftPicks[j].Year {
+			return draftPicks[i].Year < draftPicks[j].Year
+		}
+		return draftPicks[i].Round < draftPicks[j].Round
+	})
+
+	return draftPicks
+}
+
+// leagueDraftRounds reads the league's rookie draft rounds, defaulting to 3
+func leagueDraftRounds(league map[string]interface{}) int {
+	if settings, ok := league["settings"].(map[string]interface{}); ok {
+		if rounds, ok := settings["draft_rounds"].(float64); ok && rounds > 0 {
+			return int(rounds)
+		}
+	}
+	return 3
+}
+
+// buildPickOwnership maps "year-round-original_roster_id" to the roster that currently owns
+// each pick, starting from default ownership and applying traded picks
+func buildPickOwnership(
+	tradedPicks []map[string]interface{},
+	rosters []map[string]interface{},
+	numRounds int,
+	userRosterID int,
+	currentYear int,
+	debugf debugLogger,
+) map[string]int {
+	if debugf == nil {
+		debugf = func(format string, args ...interface{}) {}
+	}
+
 	// Build year set from next 3 years plus any years present in traded picks
 	years := make(map[int]struct{})
 	for year := currentYear; year < currentYear+3; year++ {
@@ -115,63 +200,7 @@ func buildDraftPicks(
 		debugf("[DEBUG] ===================================")
 	}
 
-	// Extract user's picks
-	debugf("[DEBUG] ===== EXTRACTING USER PICKS =====")
-	debugf("[DEBUG] Searching for picks owned by roster %d", userRosterID)
-	debugf("[DEBUG] Total picks in ownership map: %d", len(pickOwnership))
-
-	draftPicks := make([]DraftPick, 0)
-	for key, ownerRosterID := range pickOwnership {
-		if ownerRosterID != userRosterID {
-			debugf("[DEBUG] Skipping pick %s: owned by roster %d (not user)", key, ownerRosterID)
-			continue
-		}
-
-		parts := strings.Split(key, "-")
-		if len(parts) != 3 {
-			debugf("[DEBUG] Invalid pick key format: %s", key)
-			continue
-		}
-		year, _ := strconv.Atoi(parts[0])
-		round, _ := strconv.Atoi(parts[1])
-		originalRosterID, _ := strconv.Atoi(parts[2])
-
-		ownerName := "You"
-		originalName := ""
-
-		if originalRosterID != userRosterID {
-			// User acquired this pick from another team
-			if origOwner, exists := rosterOwners[originalRosterID]; exists {
-				originalName = origOwner
-			} else {
-				originalName = fmt.Sprintf("Team %d", originalRosterID)
-			}
-			debugf("[DEBUG] ✓ Pick %d Round %d: ACQUIRED from roster %d (%s)", year, round, originalRosterID, originalName)
-		} else {
-			debugf("[DEBUG] ✓ Pick %d Round %d: YOUR ORIGINAL pick", year, round)
-		}
-
-		draftPicks = append(draftPicks, DraftPick{
-			Round:        round,
-			Year:         year,
-			OwnerName:    ownerName,
-			OriginalName: originalName,
-			RosterID:     userRosterID,
-			IsYours:      true,
-		})
-	}
-
-	debugf("[DEBUG] ===================================")
-	debugf("[DEBUG] FINAL RESULT: User has %d draft picks total", len(draftPicks))
-
-	sort.Slice(draftPicks, func(i, j int) bool {
-		if draftPicks[i].Year != draftPicks[j].Year {
-			return draftPicks[i].Year < draftPicks[j].Year
-		}
-		return draftPicks[i].Round < draftPicks[j].Round
-	})
-
-	return draftPicks
+	return pickOwnership
 }
 
 func parsePickYear(v interface{}) int {
@@ -0,0 +1,549 @@
+// ABOUTME: Live draft room for startup, rookie and redraft drafts on Sleeper
+// ABOUTME: Polls the league's active draft, keeps a best-available board, flags need fits, and predicts who goes before the user's pick
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DRAFT_BOARD_SIZE      = 60 // Best-available players shown
+	DRAFT_RECENT_PICKS    = 12
+	DRAFT_REFRESH_SECONDS = 15 // Page poll interval while the draft is live
+)
+
+// DraftBoardPlayer is one available player on the best-available board
+type DraftBoardPlayer struct {
+	Player    PlayerRow
+	Rank      int     // Position on the board (1 = best available)
+	FillsNeed bool    // Plays a position the user needs
+	GonePct   float64 // Chance he's taken before the user's next pick (0-100)
+}
+
+// DraftRoomPick is a pick already made in the draft
+type DraftRoomPick struct {
+	PickNo   int
+	Round    int
+	Slot     int
+	TeamName string
+	Player   string
+	Pos      string
+	IsUser   bool
+}
+
+// DraftRoomPage is the draft room view (also served as JSON with format=json)
+type DraftRoomPage struct {
+	LeagueID       string
+	LeagueName     string
+	Username       string
+	DraftID        string
+	Status         string // Sleeper draft status: pre_draft, drafting, paused, complete
+	DraftType      string // snake, linear, auction
+	IsRookieDraft  bool
+	IsDynasty      bool
+	BoardSource    string // "dynasty values" or "tiers"
+	Teams          int
+	Rounds         int
+	CurrentPick    int // Overall number of the pick on the clock (0 once the draft is complete)
+	UserSlot       int // 0 when the user isn't in the draft
+	NextUserPick   int // Overall number of the user's next pick (0 = none left)
+	PicksUntilTurn int // Picks other teams make before the user's next pick
+	OnTheClock     bool
+	Needs          []RookieDraftNeed
+	Board          []DraftBoardPlayer
+	Target         *DraftBoardPlayer // Best need fit likely to last until the user's pick
+	RecentPicks    []DraftRoomPick   // Latest first
+	UserPicks      []DraftRoomPick
+	RefreshSeconds int // 0 when the draft isn't live
+}
+
+// selectActiveDraft prefers a live draft, then one about to start, then the most recent
+func selectActiveDraft(drafts []map[string]interface{}) map[string]interface{} {
+	for _, status := range []string{"drafting", "paused", "pre_draft"} {
+		for _, d := range drafts {
+			if s, _ := d["status"].(string); s == status {
+				return d
+			}
+		}
+	}
+	if len(drafts) > 0 {
+		return drafts[0]
+	}
+	return nil
+}
+
+// draftSetting reads a numeric draft setting
+func draftSetting(draft map[string]interface{}, key string) int {
+	if settings, ok := draft["settings"].(map[string]interface{}); ok {
+		if v, ok := settings[key].(float64); ok {
+			return int(v)
+		}
+	}
+	return 0
+}
+
+// draftSlotForPick returns the draft slot (1..teams) making an overall pick. Snake drafts reverse
+// every round; a reversal round (third-round reversal) flips the direction once more from there.
+func draftSlotForPick(pickNo, teams int, draftType string, reversalRound int) int {
+	if pickNo <= 0 || teams <= 0 {
+		return 0
+	}
+	round := (pickNo-1)/teams + 1
+	idx := (pickNo - 1) % teams
+	if draftType != "snake" {
+		return idx + 1
+	}
+	reversed := round%2 == 0
+	if reversalRound > 0 && round >= reversalRound {
+		reversed = !reversed
+	}
+	if reversed {
+		return teams - idx
+	}
+	return idx + 1
+}
+
+// draftPickOwnership resolves which roster holds each pick of a draft once trades are applied
+type draftPickOwnership struct {
+	slotRosters map[int]int         // Draft slot -> roster that originally holds it
+	traded      map[int]map[int]int // Round -> original roster ID -> current owner roster ID
+}
+
+// newDraftPickOwnership reads the draft's slot_to_roster_id map and its traded picks
+func newDraftPickOwnership(draft map[string]interface{}, tradedPicks []map[string]interface{}) draftPickOwnership {
+	o := draftPickOwnership{slotRosters: map[int]int{}, traded: map[int]map[int]int{}}
+	if slots, ok := draft["slot_to_roster_id"].(map[string]interface{}); ok {
+		for slot, rid := range slots {
+			s, err := strconv.Atoi(slot)
+			if v, ok := rid.(float64); ok && err == nil && s > 0 {
+				o.slotRosters[s] = int(v)
+			}
+		}
+	}
+	for _, tp := range tradedPicks {
+		round, _ := tp["round"].(float64)
+		original, _ := tp["roster_id"].(float64)
+		owner, _ := tp["owner_id"].(float64)
+		if round <= 0 || original <= 0 || owner <= 0 {
+			continue
+		}
+		if o.traded[int(round)] == nil {
+			o.traded[int(round)] = map[int]int{}
+		}
+		o.traded[int(round)][int(original)] = int(owner)
+	}
+	return o
+}
+
+// owner returns the roster holding a round's pick from a slot (0 when the slot isn't mapped yet)
+func (o draftPickOwnership) owner(round, slot int) int {
+	original := o.slotRosters[slot]
+	if original == 0 {
+		return 0
+	}
+	if owner, ok := o.traded[round][original]; ok {
+		return owner
+	}
+	return original
+}
+
+// nextPickForRoster finds the roster's first pick at or after fromPick, counting picks it
+// acquired by trade and skipping ones it traded away (0 = none left)
+func nextPickForRoster(rosterID, fromPick, teams, rounds int, draftType string, reversalRound int, owners draftPickOwnership) int {
+	if rosterID <= 0 || fromPick <= 0 || teams <= 0 {
+		return 0
+	}
+	for p := fromPick; p <= teams*rounds; p++ {
+		round := (p-1)/teams + 1
+		if owners.owner(round, draftSlotForPick(p, teams, draftType, reversalRound)) == rosterID {
+			return p
+		}
+	}
+	return 0
+}
+
+// goneProbability estimates the chance the player at a 0-based board index is drafted within
+// the next picks selections, treating the board as a consensus ADP with spread growing down the board
+func goneProbability(boardIndex, picks int) float64 {
+	if picks <= 0 {
+		return 0
+	}
+	rank := float64(boardIndex + 1)
+	sigma := 1 + 0.15*rank
+	z := (float64(picks) + 0.5 - rank) / sigma
+	p := 0.5 * (1 + math.Erf(z/math.Sqrt2))
+	return math.Round(p*1000) / 10
+}
+
+// buildDraftBoard ranks available players by dynasty value (dynasty) or tier and annotates need
+// fits and the chance each is gone before the user picks again
+func buildDraftBoard(pool []PlayerRow, byValue bool, needs map[string]bool, picksBefore int) []DraftBoardPlayer {
+	ranked := []PlayerRow{}
+	for _, row := range pool {
+		if byValue && row.DynastyValue <= 0 {
+			continue
+		}
+		if !byValue && parseTierFloat(row.Tier) <= 0 {
+			continue
+		}
+		ranked = append(ranked, row)
+	}
+	if byValue {
+		sortFreeAgents(ranked, "value")
+	} else {
+		sortFreeAgents(ranked, "tier")
+	}
+	if len(ranked) > DRAFT_BOARD_SIZE {
+		ranked = ranked[:DRAFT_BOARD_SIZE]
+	}
+	board := make([]DraftBoardPlayer, len(ranked))
+	for i, row := range ranked {
+		board[i] = DraftBoardPlayer{
+			Player:    row,
+			Rank:      i + 1,
+			FillsNeed: needs[row.Pos],
+			GonePct:   goneProbability(i, picksBefore),
+		}
+	}
+	return board
+}
+
+// draftTarget is the best need fit on the board that's more likely than not to be there at the user's pick
+func draftTarget(board []DraftBoardPlayer) *DraftBoardPlayer {
+	for i := range board {
+		if board[i].FillsNeed && board[i].GonePct < 50 {
+			return &board[i]
+		}
+	}
+	return nil
+}
+
+// lineupDraftNeeds flags positions where the user has fewer players than the lineup starts,
+// for redraft drafts where dynasty-value needs don't apply
+func lineupDraftNeeds(rows []PlayerRow, rosterPositions []string) []RookieDraftNeed {
+	have := map[string]int{}
+	for _, row := range rows {
+		have[row.RealPos]++
+	}
+	demand := positionDemand(rosterPositions)
+	needs := []RookieDraftNeed{}
+	for _, pos := range fantasyPositions {
+		want := int(math.Ceil(demand[pos]))
+		if want == 0 || have[pos] >= want {
+			continue
+		}
+		priority := 2
+		if have[pos] == 0 {
+			priority = 1
+		}
+		needs = append(needs, RookieDraftNeed{
+			Position:  pos,
+			Priority:  priority,
+			Reasoning: fmt.Sprintf("%d of %d starters drafted", have[pos], want),
+		})
+	}
+	return needs
+}
+
+// draftNeedPositions returns the positions worth highlighting (priority 1-2)
+func draftNeedPositions(needs []RookieDraftNeed) map[string]bool {
+	out := map[string]bool{}
+	for _, n := range needs {
+		if n.Priority > 0 && n.Priority <= 2 {
+			out[n.Position] = true
+		}
+	}
+	return out
+}
+
+// draftPickPlayer reads the player name and position Sleeper attaches to a pick
+func draftPickPlayer(pick map[string]interface{}, players map[string]interface{}) (string, string) {
+	pid, _ := pick["player_id"].(string)
+	if p, ok := players[pid].(map[string]interface{}); ok {
+		pos, _ := p["position"].(string)
+		return getPlayerName(p), pos
+	}
+	meta, _ := pick["metadata"].(map[string]interface{})
+	first, _ := meta["first_name"].(string)
+	last, _ := meta["last_name"].(string)
+	pos, _ := meta["position"].(string)
+	return strings.TrimSpace(first + " " + last), pos
+}
+
+func draftRoomHandler(w http.ResponseWriter, r *http.Request) {
+	trackPageView(r.URL.Path)
+	q := r.URL.Query()
+	leagueID := strings.TrimSpace(q.Get("league_id"))
+	if leagueID == "" {
+		http.Error(w, "league_id is required", http.StatusBadRequest)
+		return
+	}
+	username := strings.TrimSpace(q.Get("user"))
+	if username == "" {
+		if cookie, err := r.Cookie("sleeper_username"); err == nil {
+			username = cookie.Value
+		}
+	}
+
+	page, err := buildDraftRoomPage(leagueID, strings.TrimSpace(q.Get("draft_id")), username)
+	if err != nil {
+		log.Printf("[ERROR] Draft room failed for league %s: %v", leagueID, err)
+		totalErrors.Inc()
+		http.Error(w, "failed to load draft", http.StatusBadGateway)
+		return
+	}
+
+	if q.Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+		return
+	}
+	if err := templates.ExecuteTemplate(w, "draft_room.html", page); err != nil {
+		log.Printf("[ERROR] Draft room template error: %v", err)
+		http.Error(w, "Error rendering draft room", http.StatusInternalServerError)
+	}
+}
+
+// buildDraftRoomPage loads the league's active (or requested) draft and builds the board
+func buildDraftRoomPage(leagueID, draftID, username string) (*DraftRoomPage, error) {
+	league, err := appProvider.FetchLeague(leagueID)
+	if err != nil || league == nil || league["league_id"] == nil {
+		return nil, fmt.Errorf("league %s not found: %v", leagueID, err)
+	}
+	var draft map[string]interface{}
+	if draftID != "" {
+		draft, err = appProvider.FetchDraft(draftID)
+	} else {
+		var drafts []map[string]interface{}
+		drafts, err = appProvider.FetchLeagueDrafts(leagueID)
+		draft = selectActiveDraft(drafts)
+	}
+	if err != nil || draft == nil || draft["draft_id"] == nil {
+		return nil, fmt.Errorf("no draft for league %s: %v", leagueID, err)
+	}
+	draftID, _ = draft["draft_id"].(string)
+	picks, err := appProvider.FetchDraftPicks(draftID)
+	if err != nil {
+		return nil, fmt.Errorf("draft picks: %w", err)
+	}
+	tradedPicks, err := appProvider.FetchDraftTradedPicks(draftID)
+	if err != nil {
+		debugLog("[DEBUG] No traded picks for draft %s: %v", draftID, err)
+	}
+	rosters, err := appProvider.FetchLeagueRosters(leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("rosters: %w", err)
+	}
+	users, _ := appProvider.FetchLeagueUsers(leagueID)
+	players, err := fetchPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("players: %w", err)
+	}
+
+	userID := ""
+	if username != "" {
+		if user, err := appProvider.FetchUser(username); err == nil && user != nil {
+			userID, _ = user["user_id"].(string)
+		}
+	}
+
+	isDynasty := isDynastyLeague(league)
+	isSuperFlex := leagueIsSuperFlex(league)
+	var dynastyValues map[string]DynastyValue
+	if isDynasty {
+		dynastyValues, _ = fetchDynastyValues()
+	}
+	tiers := fetchBorisTiers(leagueScoringFormat(league))
+	rosterPositions := toStringSlice(league["roster_positions"])
+
+	page := buildDraftRoom(draftRoomInputs{
+		League:          league,
+		Draft:           draft,
+		Picks:           picks,
+		TradedPicks:     tradedPicks,
+		Rosters:         rosters,
+		UserNames:       leagueUserNames(users),
+		UserID:          userID,
+		Players:         players,
+		Tiers:           tiers,
+		DynastyValues:   dynastyValues,
+		IsSuperFlex:     isSuperFlex,
+		RosterPositions: rosterPositions,
+	})
+	page.Username = username
+	return page, nil
+}
+
+// draftRoomInputs is everything the draft room needs, already fetched
+type draftRoomInputs struct {
+	League          map[string]interface{}
+	Draft           map[string]interface{}
+	Picks           []map[string]interface{}
+	TradedPicks     []map[string]interface{} // The draft's traded picks
+	Rosters         []map[string]interface{}
+	UserNames       map[string]string
+	UserID          string
+	Players         map[string]interface{}
+	Tiers           map[string][][]string
+	DynastyValues   map[string]DynastyValue
+	IsSuperFlex     bool
+	RosterPositions []string
+}
+
+// buildDraftRoom assembles the draft room from fetched league, draft, and pick data
+func buildDraftRoom(in draftRoomInputs) *DraftRoomPage {
+	page := &DraftRoomPage{
+		IsDynasty: isDynastyLeague(in.League),
+		Teams:     draftSetting(in.Draft, "teams"),
+		Rounds:    draftSetting(in.Draft, "rounds"),
+	}
+	page.LeagueID, _ = in.League["league_id"].(string)
+	page.LeagueName, _ = in.League["name"].(string)
+	page.DraftID, _ = in.Draft["draft_id"].(string)
+	page.Status, _ = in.Draft["status"].(string)
+	page.DraftType, _ = in.Draft["type"].(string)
+	page.IsRookieDraft = draftSetting(in.Draft, "player_type") == 1
+	reversalRound := draftSetting(in.Draft, "reversal_round")
+	if page.Teams == 0 {
+		page.Teams = len(in.Rosters)
+	}
+
+	// Team names by roster, and the user's roster
+	teamByRoster := map[int]string{}
+	userRosterID := 0
+	for _, r := range in.Rosters {
+		rid, _ := r["roster_id"].(float64)
+		teamByRoster[int(rid)] = rosterTeamName(r, in.UserNames)
+		if owner, _ := r["owner_id"].(string); in.UserID != "" && owner == in.UserID {
+			userRosterID = int(rid)
+		}
+	}
+
+	// The user's slot comes from the draft order, or from the slot mapped to their roster
+	if order, ok := in.Draft["draft_order"].(map[string]interface{}); ok && in.UserID != "" {
+		if slot, ok := order[in.UserID].(float64); ok {
+			page.UserSlot = int(slot)
+		}
+	}
+	owners := newDraftPickOwnership(in.Draft, in.TradedPicks)
+	if page.UserSlot == 0 && userRosterID > 0 {
+		for slot, rid := range owners.slotRosters {
+			if rid == userRosterID {
+				page.UserSlot = slot
+			}
+		}
+	}
+	if page.UserSlot > 0 && userRosterID > 0 && owners.slotRosters[page.UserSlot] == 0 {
+		owners.slotRosters[page.UserSlot] = userRosterID // Order set for the user before Sleeper maps slots
+	}
+
+	// Picks made so far
+	drafted := buildRosteredSet(in.Rosters)
+	userPlayerIDs := []string{}
+	userHas := map[string]bool{}
+	for _, r := range in.Rosters {
+		if rid, _ := r["roster_id"].(float64); int(rid) == userRosterID && userRosterID > 0 {
+			for _, pid := range toStringSlice(r["players"]) {
+				userPlayerIDs = append(userPlayerIDs, pid)
+				userHas[pid] = true
+			}
+		}
+	}
+	made := []DraftRoomPick{}
+	for _, p := range in.Picks {
+		pid, _ := p["player_id"].(string)
+		if pid != "" {
+			drafted[pid] = true
+		}
+		pickNo, _ := p["pick_no"].(float64)
+		round, _ := p["round"].(float64)
+		slot, _ := p["draft_slot"].(float64)
+		rid, _ := p["roster_id"].(float64)
+		pickedBy, _ := p["picked_by"].(string)
+		name, pos := draftPickPlayer(p, in.Players)
+		team := teamByRoster[int(rid)]
+		if team == "" {
+			team = in.UserNames[pickedBy]
+		}
+		// The roster that made the pick, not the slot, says whose it is once picks change hands
+		isUser := userRosterID > 0 && int(rid) == userRosterID
+		if rid == 0 {
+			isUser = in.UserID != "" && pickedBy == in.UserID
+		}
+		pick := DraftRoomPick{PickNo: int(pickNo), Round: int(round), Slot: int(slot), TeamName: team, Player: name, Pos: pos, IsUser: isUser}
+		made = append(made, pick)
+		if isUser {
+			page.UserPicks = append(page.UserPicks, pick)
+			if pid != "" && !userHas[pid] {
+				userPlayerIDs = append(userPlayerIDs, pid)
+				userHas[pid] = true
+			}
+		}
+	}
+	sort.Slice(made, func(i, j int) bool { return made[i].PickNo > made[j].PickNo })
+	if len(made) > DRAFT_RECENT_PICKS {
+		made = made[:DRAFT_RECENT_PICKS]
+	}
+	page.RecentPicks = made
+
+	// Where the draft stands and when the user picks next
+	total := page.Teams * page.Rounds
+	if page.Status != "complete" && len(in.Picks) < total {
+		page.CurrentPick = len(in.Picks) + 1
+		page.NextUserPick = nextPickForRoster(userRosterID, page.CurrentPick, page.Teams, page.Rounds, page.DraftType, reversalRound, owners)
+		if page.NextUserPick > 0 {
+			page.PicksUntilTurn = page.NextUserPick - page.CurrentPick
+			page.OnTheClock = page.PicksUntilTurn == 0
+		}
+	}
+	if page.Status == "drafting" {
+		page.RefreshSeconds = DRAFT_REFRESH_SECONDS
+	}
+
+	// Needs: dynasty value gaps for dynasty leagues, unfilled starting spots otherwise
+	userRows, unranked, _ := buildRowsWithPositions(userPlayerIDs, in.Players, in.Tiers, false, nil, nil, nil)
+	userRows = append(userRows, unranked...)
+	for i := range userRows {
+		if userRows[i].RealPos == "" {
+			userRows[i].RealPos = userRows[i].Pos
+		}
+	}
+	byValue := page.IsDynasty && len(in.DynastyValues) > 0
+	if byValue {
+		enrichRowsWithDynastyValues(userRows, in.DynastyValues, in.IsSuperFlex)
+		page.Needs = analyzePositionalNeeds(LeagueData{
+			IsDynasty:           true,
+			Bench:               userRows,
+			PositionalBreakdown: calculatePositionalKTC(userRows),
+		})
+		page.BoardSource = "dynasty values"
+	} else {
+		page.Needs = lineupDraftNeeds(userRows, in.RosterPositions)
+		page.BoardSource = "tiers"
+	}
+
+	// Best available board
+	pool := buildFreeAgentPool(in.Players, drafted, in.Tiers, in.DynastyValues, in.IsSuperFlex, nil, nil, nil)
+	if page.IsRookieDraft {
+		rookies := pool[:0]
+		for _, row := range pool {
+			if p, ok := in.Players[row.PlayerID].(map[string]interface{}); ok {
+				if exp, _ := p["years_exp"].(float64); exp == 0 {
+					rookies = append(rookies, row)
+				}
+			}
+		}
+		pool = rookies
+	}
+	page.Board = buildDraftBoard(pool, byValue, draftNeedPositions(page.Needs), page.PicksUntilTurn)
+	page.Target = draftTarget(page.Board)
+	return page
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDraftSlotForPick(t *testing.T) {
+	cases := []struct {
+		pick     int
+		kind     string
+		reversal int
+		want     int
+	}{
+		{1, "snake", 0, 1},
+		{4, "snake", 0, 4},
+		{5, "snake", 0, 4},
+		{8, "snake", 0, 1},
+		{9, "snake", 0, 1},
+		{5, "linear", 0, 1},
+		{9, "snake", 3, 4},  // Third-round reversal: round 3 runs backwards
+		{13, "snake", 3, 1}, // and round 4 forwards
+	}
+	for _, c := range cases {
+		if got := draftSlotForPick(c.pick, 4, c.kind, c.reversal); got != c.want {
+			t.Fatalf("pick %d (%s, reversal %d): expected slot %d, got %d", c.pick, c.kind, c.reversal, c.want, got)
+		}
+	}
+	owners := draftPickOwnership{slotRosters: map[int]int{1: 11, 2: 12, 3: 13, 4: 14}}
+	if got := nextPickForRoster(12, 3, 4, 2, "snake", 0, owners); got != 7 {
+		t.Fatalf("expected slot 2 to pick next at 7, got %d", got)
+	}
+	if got := nextPickForRoster(12, 8, 4, 2, "snake", 0, owners); got != 0 {
+		t.Fatalf("expected no picks left, got %d", got)
+	}
+}
+
+func TestNewDraftPickOwnershipAppliesTrades(t *testing.T) {
+	draft := map[string]interface{}{"slot_to_roster_id": map[string]interface{}{"1": float64(2), "2": float64(1)}}
+	traded := []map[string]interface{}{
+		{"season": "2026", "round": float64(2), "roster_id": float64(2), "owner_id": float64(1), "previous_owner_id": float64(2)},
+	}
+	owners := newDraftPickOwnership(draft, traded)
+	if owners.owner(1, 1) != 2 || owners.owner(2, 1) != 1 || owners.owner(2, 2) != 1 || owners.owner(1, 3) != 0 {
+		t.Fatalf("unexpected pick owners: %+v", owners)
+	}
+	if got := nextPickForRoster(2, 3, 2, 2, "snake", 0, owners); got != 0 {
+		t.Fatalf("expected the traded-away second-rounder to leave roster 2 without picks, got %d", got)
+	}
+}
+
+func TestGoneProbability(t *testing.T) {
+	if p := goneProbability(0, 5); p < 99 {
+		t.Fatalf("expected best available to be gone after 5 picks, got %.1f", p)
+	}
+	if p := goneProbability(9, 5); p > 10 {
+		t.Fatalf("expected 10th best to likely survive 5 picks, got %.1f", p)
+	}
+	if p := goneProbability(0, 0); p != 0 {
+		t.Fatalf("expected nothing gone when on the clock, got %.1f", p)
+	}
+}
+
+func draftRoomTestInputs() draftRoomInputs {
+	player := func(first, last, pos string, rostered float64) map[string]interface{} {
+		return map[string]interface{}{"first_name": first, "last_name": last, "position": pos, "roster_percent": rostered, "years_exp": float64(4)}
+	}
+	players := map[string]interface{}{
+		"qa": player("Alpha", "Passer", "QB", 90),
+		"rb": player("Bravo", "Back", "RB", 99),
+		"rc": player("Charlie", "Back", "RB", 70),
+		"wd": player("Delta", "Wideout", "WR", 99),
+		"we": player("Echo", "Wideout", "WR", 50),
+		"tf": player("Foxtrot", "End", "TE", 80),
+	}
+	players["tf"].(map[string]interface{})["years_exp"] = float64(0)
+	return draftRoomInputs{
+		League: map[string]interface{}{"league_id": "L1", "name": "Draft League"},
+		Draft: map[string]interface{}{
+			"draft_id":    "D1",
+			"status":      "drafting",
+			"type":        "snake",
+			"settings":    map[string]interface{}{"teams": float64(3), "rounds": float64(2)},
+			"draft_order": map[string]interface{}{"u3": float64(3)},
+		},
+		Picks: []map[string]interface{}{
+			{"pick_no": float64(1), "round": float64(1), "draft_slot": float64(1), "roster_id": float64(1), "player_id": "rb", "picked_by": "u1"},
+		},
+		Rosters: []map[string]interface{}{
+			{"roster_id": float64(1), "owner_id": "u1"},
+			{"roster_id": float64(2), "owner_id": "u2"},
+			{"roster_id": float64(3), "owner_id": "u3"},
+		},
+		UserNames: map[string]string{"u1": "First", "u2": "Second", "u3": "Me"},
+		UserID:    "u3",
+		Players:   players,
+		Tiers: map[string][][]string{
+			"QB": {{"Alpha Passer"}},
+			"RB": {{"Bravo Back"}, {"Charlie Back"}},
+			"WR": {{"Delta Wideout"}, {}, {"Echo Wideout"}},
+			"TE": {{"Foxtrot End"}},
+		},
+		RosterPositions: []string{"QB", "RB", "WR", "TE", "BN"},
+	}
+}
+
+func TestBuildDraftRoom(t *testing.T) {
+	page := buildDraftRoom(draftRoomTestInputs())
+	if page.UserSlot != 3 || page.CurrentPick != 2 || page.NextUserPick != 3 || page.PicksUntilTurn != 1 || page.OnTheClock {
+		t.Fatalf("unexpected draft position: %+v", page)
+	}
+	if page.RefreshSeconds != DRAFT_REFRESH_SECONDS || page.BoardSource != "tiers" {
+		t.Fatalf("expected a live tier board: %+v", page)
+	}
+	if len(page.RecentPicks) != 1 || page.RecentPicks[0].TeamName != "First" || page.RecentPicks[0].Player != "Bravo Back" {
+		t.Fatalf("unexpected recent picks: %+v", page.RecentPicks)
+	}
+	if len(page.Needs) != 4 {
+		t.Fatalf("expected every starting position to be a need, got %+v", page.Needs)
+	}
+
+	names := []string{}
+	for _, b := range page.Board {
+		names = append(names, b.Player.Name)
+		if b.Player.Name == "Bravo Back" {
+			t.Fatalf("drafted player still on the board")
+		}
+	}
+	want := []string{"Delta Wideout", "Alpha Passer", "Foxtrot End", "Charlie Back", "Echo Wideout"}
+	if len(names) != len(want) {
+		t.Fatalf("unexpected board: %v", names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("unexpected board order: %v", names)
+		}
+	}
+	if !page.Board[0].FillsNeed || page.Board[0].GonePct < 50 {
+		t.Fatalf("expected the top player to fill a need and likely be gone: %+v", page.Board[0])
+	}
+	if page.Target == nil || page.Target.Player.Name != "Alpha Passer" {
+		t.Fatalf("expected the QB as the likely-available target, got %+v", page.Target)
+	}
+
+	in := draftRoomTestInputs()
+	in.Draft["settings"].(map[string]interface{})["player_type"] = float64(1)
+	rookies := buildDraftRoom(in)
+	if !rookies.IsRookieDraft || len(rookies.Board) != 1 || rookies.Board[0].Player.Name != "Foxtrot End" {
+		t.Fatalf("expected rookie draft board to hold only rookies: %+v", rookies.Board)
+	}
+}
+
+func TestBuildDraftRoomTradedPicks(t *testing.T) {
+	in := draftRoomTestInputs()
+	in.Draft["slot_to_roster_id"] = map[string]interface{}{"1": float64(1), "2": float64(2), "3": float64(3)}
+	in.TradedPicks = []map[string]interface{}{
+		{"round": float64(1), "roster_id": float64(3), "owner_id": float64(1)},
+		{"round": float64(2), "roster_id": float64(3), "owner_id": float64(1)},
+		{"round": float64(2), "roster_id": float64(2), "owner_id": float64(3)},
+	}
+	in.Picks = append(in.Picks,
+		map[string]interface{}{"pick_no": float64(2), "round": float64(1), "draft_slot": float64(2), "roster_id": float64(2), "player_id": "wd", "picked_by": "u2"},
+		// Roster 1 uses the user's traded-away slot
+		map[string]interface{}{"pick_no": float64(3), "round": float64(1), "draft_slot": float64(3), "roster_id": float64(1), "player_id": "qa", "picked_by": "u1"},
+	)
+	page := buildDraftRoom(in)
+	if page.UserSlot != 3 || page.CurrentPick != 4 || page.NextUserPick != 5 || page.PicksUntilTurn != 1 {
+		t.Fatalf("expected the user's next pick to be the acquired 2.02: %+v", page)
+	}
+	if len(page.UserPicks) != 0 || page.RecentPicks[0].IsUser {
+		t.Fatalf("expected the pick made from the user's traded slot to belong to its new owner: %+v", page.UserPicks)
+	}
+}
+
+func TestLineupDraftNeeds(t *testing.T) {
+	rows := []PlayerRow{{RealPos: "QB"}, {RealPos: "RB"}}
+	needs := lineupDraftNeeds(rows, []string{"QB", "RB", "RB", "WR", "BN"})
+	if len(needs) != 2 || needs[0].Position != "RB" || needs[0].Priority != 2 || needs[1].Position != "WR" || needs[1].Priority != 1 {
+		t.Fatalf("unexpected lineup needs: %+v", needs)
+	}
+}
+
+func TestDraftRoomHandlerRequiresLeague(t *testing.T) {
+	rec := httptest.NewRecorder()
+	draftRoomHandler(rec, httptest.NewRequest(http.MethodGet, "/draft", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without league_id, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,196 @@
+// ABOUTME: Drop-candidate pairing for waiver adds and roster legality checks
+// ABOUTME: Ranks droppable bench players by tier, value, age, bye, and injury status
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// buildRosterStatus counts roster capacity from roster_positions and flags Sleeper legality problems
+func buildRosterStatus(league map[string]interface{}, rosterPositions []string, activePlayers int, irRows []PlayerRow, week int) RosterStatus {
+	status := RosterStatus{ActivePlayers: activePlayers, StarterSlots: starterSlots(rosterPositions), Week: week}
+	for _, pos := range rosterPositions {
+		if pos != "IR" && pos != "TAXI" {
+			status.ActiveSlots++
+		}
+	}
+	status.OpenSpots = status.ActiveSlots - activePlayers
+	if status.OpenSpots < 0 {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("Roster has %d players for %d spots - drop %d before adding anyone", activePlayers, status.ActiveSlots, -status.OpenSpots))
+		status.OpenSpots = 0
+	}
+
+	for _, row := range irRows {
+		if !isReserveEligible(row.InjuryStatus, league) {
+			status.Warnings = append(status.Warnings, fmt.Sprintf("%s is no longer IR-eligible - Sleeper blocks adds until they leave the IR slot", stripHTML(row.Name)))
+		}
+	}
+	return status
+}
+
+// isReserveEligible applies the league's reserve_allow_* settings to an injury status
+func isReserveEligible(injuryStatus string, league map[string]interface{}) bool {
+	settings, _ := league["settings"].(map[string]interface{})
+	allowed := func(key string) bool {
+		v, _ := settings[key].(float64)
+		return v == 1
+	}
+	switch injuryStatus {
+	case "IR", "PUP":
+		return true
+	case "Out":
+		return allowed("reserve_allow_out")
+	case "Doubtful":
+		return allowed("reserve_allow_doubtful")
+	case "Sus":
+		return allowed("reserve_allow_sus")
+	case "NA":
+		return allowed("reserve_allow_na")
+	case "COV":
+		return allowed("reserve_allow_cov")
+	}
+	return false
+}
+
+// rowPosition returns a row's real position, falling back to its display position
+func rowPosition(row PlayerRow) string {
+	if row.RealPos != "" {
+		return row.RealPos
+	}
+	return row.Pos
+}
+
+// applyByeWeeks sets ByeWeek on rows from a team -> bye week map
+func applyByeWeeks(rows []PlayerRow, byes map[string]int) {
+	for i := range rows {
+		rows[i].ByeWeek = byes[rows[i].Team]
+	}
+}
+
+// dropKeepScore rates how much a player is worth keeping (lower = drop first).
+// Redraft leagues weigh this week's availability; dynasty leagues weigh long-term value and age.
+func dropKeepScore(row PlayerRow, isDynasty bool, week int) (float64, string) {
+	score := 0.0
+	reasons := []string{}
+
+	if tier := int(parseTierFloat(row.Tier)); tier > 0 {
+		score += tierLineupScore(tier) * 3
+		reasons = append(reasons, fmt.Sprintf("tier %d", tier))
+	} else {
+		reasons = append(reasons, "unranked")
+	}
+
+	if isDynasty {
+		score += float64(row.DynastyValue) / 100
+		if row.DynastyValue > 0 {
+			reasons = append(reasons, fmt.Sprintf("value %d", row.DynastyValue))
+		}
+		switch {
+		case row.Age > 0 && row.Age < 25:
+			score += 5
+		case row.Age >= 30:
+			score -= 5
+			reasons = append(reasons, fmt.Sprintf("age %d", row.Age))
+		}
+	}
+
+	switch row.InjuryStatus {
+	case "Out", "Doubtful", "IR", "PUP", "Sus":
+		if isDynasty {
+			score -= 3
+		} else {
+			score -= 10
+		}
+		reasons = append(reasons, row.InjuryStatus)
+	case "Questionable":
+		score -= 2
+	}
+
+	if !isDynasty && week > 0 && row.ByeWeek > 0 {
+		switch {
+		case row.ByeWeek == week:
+			score -= 4
+			reasons = append(reasons, "on bye this week")
+		case row.ByeWeek > week && row.ByeWeek <= week+2:
+			score -= 2
+			reasons = append(reasons, fmt.Sprintf("bye week %d", row.ByeWeek))
+		}
+	}
+	return score, strings.Join(reasons, ", ")
+}
+
+// rankDropCandidates orders droppable bench players, most droppable first. IR and taxi
+// players are excluded since they don't use an active roster spot.
+func rankDropCandidates(league LeagueData) []DropCandidate {
+	candidates := []DropCandidate{}
+	for _, rows := range [][]PlayerRow{league.Bench, league.BenchUnranked} {
+		for _, row := range rows {
+			if row.IsIR {
+				continue
+			}
+			score, reason := dropKeepScore(row, league.IsDynasty, league.RosterStatus.Week)
+			candidates = append(candidates, DropCandidate{Player: row, KeepScore: score, Reason: reason})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].KeepScore < candidates[j].KeepScore
+	})
+	return candidates
+}
+
+// lineupStillLegal reports whether the active roster can fill every starting slot
+// after swapping the dropped player for the added one
+func lineupStillLegal(league LeagueData, drop, add PlayerRow) bool {
+	slots := league.RosterStatus.StarterSlots
+	if len(slots) == 0 {
+		return true
+	}
+	candidates := []lineupCandidate{{Name: add.Name, Pos: rowPosition(add), Score: 1}}
+	for _, rows := range [][]PlayerRow{league.Starters, league.Unranked, league.Bench, league.BenchUnranked} {
+		for _, row := range rows {
+			if row.IsIR || row.Name == drop.Name {
+				continue
+			}
+			candidates = append(candidates, lineupCandidate{Name: row.Name, Pos: rowPosition(row), Score: 1})
+		}
+	}
+	lineup, _ := optimalLineup(slots, candidates)
+	return len(lineup) >= len(slots)
+}
+
+// pairDropCandidate picks who to release for an add. Returns needsDrop=false when there's an
+// open spot, and a warning when no drop keeps the roster legal.
+func pairDropCandidate(add PlayerRow, league LeagueData) (DropCandidate, bool, string) {
+	status := league.RosterStatus
+	if status.ActiveSlots == 0 {
+		return DropCandidate{}, false, "" // Roster limits unknown
+	}
+	if status.OpenSpots > 0 && len(status.Warnings) == 0 {
+		return DropCandidate{}, false, ""
+	}
+	warning := ""
+	if len(status.Warnings) > 0 {
+		warning = status.Warnings[0]
+	}
+	if status.OpenSpots > 0 {
+		return DropCandidate{}, false, warning
+	}
+
+	for _, c := range rankDropCandidates(league) {
+		if !lineupStillLegal(league, c.Player, add) {
+			continue
+		}
+		addScore, _ := dropKeepScore(add, league.IsDynasty, status.Week)
+		if warning == "" && c.KeepScore >= addScore {
+			warning = fmt.Sprintf("Every droppable player rates above %s - only add for a specific need", stripHTML(add.Name))
+		}
+		return c, true, warning
+	}
+	if warning == "" {
+		warning = fmt.Sprintf("No legal drop - adding %s would leave a starting slot unfillable", stripHTML(add.Name))
+	}
+	return DropCandidate{}, true, warning
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildRosterStatus(t *testing.T) {
+	league := map[string]interface{}{"settings": map[string]interface{}{"reserve_allow_out": float64(1)}}
+	positions := []string{"QB", "RB", "WR", "FLEX", "BN", "BN", "IR", "TAXI"}
+	irRows := []PlayerRow{
+		{Name: "Hurt Guy", InjuryStatus: "Out", IsIR: true},
+		{Name: `Healthy Guy <span>(IR)</span>`, InjuryStatus: "", IsIR: true},
+	}
+	status := buildRosterStatus(league, positions, 5, irRows, 6)
+	if status.ActiveSlots != 6 || status.OpenSpots != 1 {
+		t.Fatalf("unexpected capacity: %+v", status)
+	}
+	if len(status.Warnings) != 1 || !strings.Contains(status.Warnings[0], "Healthy Guy is no longer IR-eligible") {
+		t.Fatalf("expected warning for healthy IR player, got %v", status.Warnings)
+	}
+
+	over := buildRosterStatus(league, positions, 8, nil, 6)
+	if over.OpenSpots != 0 || len(over.Warnings) != 1 {
+		t.Fatalf("expected over-limit warning, got %+v", over)
+	}
+}
+
+func TestDropKeepScore(t *testing.T) {
+	healthy, _ := dropKeepScore(PlayerRow{Tier: 8}, false, 6)
+	hurt, reason := dropKeepScore(PlayerRow{Tier: 8, InjuryStatus: "Out"}, false, 6)
+	if hurt >= healthy || !strings.Contains(reason, "Out") {
+		t.Fatalf("expected injury to lower keep score: %.1f vs %.1f (%s)", hurt, healthy, reason)
+	}
+	onBye, reason := dropKeepScore(PlayerRow{Tier: 8, ByeWeek: 6}, false, 6)
+	if onBye >= healthy || !strings.Contains(reason, "bye") {
+		t.Fatalf("expected bye to lower redraft keep score: %.1f (%s)", onBye, reason)
+	}
+	young, _ := dropKeepScore(PlayerRow{Tier: 8, Age: 22, DynastyValue: 3000}, true, 6)
+	old, _ := dropKeepScore(PlayerRow{Tier: 8, Age: 31, DynastyValue: 3000}, true, 6)
+	if young <= old {
+		t.Fatalf("expected youth to matter in dynasty: %.1f vs %.1f", young, old)
+	}
+}
+
+func TestPairDropCandidate(t *testing.T) {
+	league := LeagueData{
+		Starters: []PlayerRow{
+			{Name: "QB One", Pos: "QB", RealPos: "QB", Tier: 3},
+			{Name: "RB One", Pos: "RB", RealPos: "RB", Tier: 4},
+		},
+		Bench: []PlayerRow{
+			{Name: "RB Two", Pos: "RB", RealPos: "RB", Tier: 9},
+			{Name: "RB Hurt", Pos: "RB", RealPos: "RB", Tier: 6, IsIR: true},
+		},
+		BenchUnranked: []PlayerRow{
+			{Name: "QB Backup", Pos: "?", RealPos: "QB", Tier: "Not Ranked"},
+		},
+		RosterStatus: RosterStatus{ActiveSlots: 4, ActivePlayers: 4, StarterSlots: []string{"QB", "RB"}},
+	}
+
+	add := PlayerRow{Name: "RB Add", Pos: "RB", Tier: 5}
+	drop, needsDrop, warning := pairDropCandidate(add, league)
+	if !needsDrop || drop.Player.Name != "QB Backup" || warning != "" {
+		t.Fatalf("expected unranked backup as drop, got %+v %v %q", drop, needsDrop, warning)
+	}
+
+	// IR players are never offered as drops
+	league.BenchUnranked = nil
+	qbAdd := PlayerRow{Name: "QB Add", Pos: "QB", Tier: 2}
+	drop, _, _ = pairDropCandidate(qbAdd, league)
+	if drop.Player.Name != "RB Two" {
+		t.Fatalf("expected bench RB as drop, got %+v", drop)
+	}
+
+	league.RosterStatus.OpenSpots = 1
+	if _, needsDrop, _ := pairDropCandidate(add, league); needsDrop {
+		t.Fatalf("expected no drop with an open roster spot")
+	}
+}
+
+func TestPairDropCandidateNoLegalDrop(t *testing.T) {
+	league := LeagueData{
+		Starters:     []PlayerRow{{Name: "K One", Pos: "K", RealPos: "K", Tier: 5}},
+		Bench:        []PlayerRow{{Name: "DEF One", Pos: "DEF", RealPos: "DEF", Tier: 10}},
+		RosterStatus: RosterStatus{ActiveSlots: 2, ActivePlayers: 2, StarterSlots: []string{"K", "DEF"}},
+	}
+	_, needsDrop, warning := pairDropCandidate(PlayerRow{Name: "WR Add", Pos: "WR", Tier: 3}, league)
+	if !needsDrop || !strings.Contains(warning, "No legal drop") {
+		t.Fatalf("expected illegal-roster warning, got %v %q", needsDrop, warning)
+	}
+}
+
+func TestByeWeeksFromSchedule(t *testing.T) {
+	games := []map[string]interface{}{
+		{"week": float64(1), "home": "KC", "away": "BUF"},
+		{"week": float64(1), "home": "DAL", "away": "PHI"},
+		{"week": float64(2), "home": "KC", "away": "DAL"},
+		{"week": float64(3), "home": "BUF", "away": "PHI"},
+	}
+	byes := byeWeeksFromSchedule(games)
+	if byes["BUF"] != 2 || byes["PHI"] != 2 || byes["KC"] != 3 || byes["DAL"] != 3 {
+		t.Fatalf("unexpected bye weeks: %v", byes)
+	}
+}
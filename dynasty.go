@@ -136,150 +136,57 @@ func aggregatePlayerNews(rosterPlayerIDs []string, players map[string]interface{
 	return newsFeed
 }
 
+// findBreakoutCandidates returns bench players the age curve expects to gain at least
+// 10% of their value over the next two seasons, biggest projected gain first
 func findBreakoutCandidates(benchRows []PlayerRow) []PlayerRow {
 	candidates := []PlayerRow{}
 
 	for _, row := range benchRows {
-		// Criteria:
-		// 1. Age < 25 (young)
-		// 2. Dynasty value > 500 (has some value)
-		// 3. Currently on bench (not starting)
-		// 4. Position is RB/WR/TE (skill positions)
-
-		if row.Age > 0 && row.Age < 25 &&
-			row.DynastyValue > 500 &&
-			(row.Pos == "RB" || row.Pos == "WR" || row.Pos == "TE") {
+		pos := rowPosition(row)
+		if row.Age <= 0 || row.DynastyValue <= 500 || (pos != "RB" && pos != "WR" && pos != "TE") {
+			continue
+		}
+		if projectedChangePct(row, 2) >= 10 {
+			row.ValueProjection = valueProjection(pos, row.Age, row.DynastyValue)
 			candidates = append(candidates, row)
 		}
 	}
 
-	// Sort by dynasty value (highest upside first)
 	sort.Slice(candidates, func(i, j int) bool {
-		return candidates[i].DynastyValue > candidates[j].DynastyValue
+		gi := candidates[i].ValueProjection[1] - candidates[i].DynastyValue
+		gj := candidates[j].ValueProjection[1] - candidates[j].DynastyValue
+		return gi > gj
 	})
 
 	return candidates
 }
 
+// findAgingPlayers is the sell-high list: players with real trade value (>1000) the age curve
+// expects to lose at least 20% of it within two seasons, biggest projected loss first
 func findAgingPlayers(startersRows, benchRows []PlayerRow) []PlayerRow {
 	aging := []PlayerRow{}
 	allPlayers := append([]PlayerRow{}, startersRows...)
 	allPlayers = append(allPlayers, benchRows...)
 
 	for _, row := range allPlayers {
-		isAging := false
-
-		// Position-specific age thresholds
-		switch row.Pos {
-		case "RB":
-			if row.Age >= 28 {
-				isAging = true
-			}
-		case "WR", "TE":
-			if row.Age >= 30 {
-				isAging = true
-			}
-		case "QB":
-			if row.Age >= 35 {
-				isAging = true
-			}
+		if row.Age <= 0 || row.DynastyValue <= 1000 {
+			continue
 		}
-
-		// Only flag if they still have trade value (>1000) and we identified them as aging
-		if isAging && row.DynastyValue > 1000 {
+		if projectedChangePct(row, 2) <= -20 {
+			row.ValueProjection = valueProjection(rowPosition(row), row.Age, row.DynastyValue)
 			aging = append(aging, row)
 		}
 	}
 
-	// Sort by age (oldest first - most urgent)
 	sort.Slice(aging, func(i, j int) bool {
-		return aging[i].Age > aging[j].Age
+		li := aging[i].DynastyValue - aging[i].ValueProjection[1]
+		lj := aging[j].DynastyValue - aging[j].ValueProjection[1]
+		return li > lj
 	})
 
 	return aging
 }
 
-func getTopRookies() []RookieProspect {
-	return []RookieProspect{
-		// 2025 NFL Draft Results (actual draft picks with NFL teams)
-		{Name: "Cam Ward", Position: "QB", College: "Miami (TEN)", Value: 4500, Rank: 1, Year: 2025},
-		{Name: "Travis Hunter", Position: "WR", College: "Colorado (JAX)", Value: 7500, Rank: 2, Year: 2025},
-		{Name: "Ashton Jeanty", Position: "RB", College: "Boise State (LV)", Value: 6800, Rank: 3, Year: 2025},
-		{Name: "Tetairoa McMillan", Position: "WR", College: "Arizona (CAR)", Value: 6500, Rank: 4, Year: 2025},
-		{Name: "Colston Loveland", Position: "TE", College: "Michigan (CHI)", Value: 3800, Rank: 5, Year: 2025},
-		{Name: "Emeka Egbuka", Position: "WR", College: "Ohio State (TB)", Value: 5500, Rank: 6, Year: 2025},
-		{Name: "Omarion Hampton", Position: "RB", College: "North Carolina (LAC)", Value: 5500, Rank: 7, Year: 2025},
-		{Name: "Matthew Golden", Position: "WR", College: "Texas (GB)", Value: 5000, Rank: 8, Year: 2025},
-		{Name: "Jaxson Dart", Position: "QB", College: "Ole Miss (NYG)", Value: 4200, Rank: 9, Year: 2025},
-		{Name: "Luther Burden III", Position: "WR", College: "Missouri", Value: 6000, Rank: 10, Year: 2025},
-
-		// 2026 NFL Draft Prospects (projections - subject to change)
-		{Name: "Dante Moore", Position: "QB", College: "Oregon", Value: 4500, Rank: 1, Year: 2026},
-		{Name: "Ty Simpson", Position: "QB", College: "Alabama", Value: 4200, Rank: 2, Year: 2026},
-		{Name: "Jordyn Tyson", Position: "WR", College: "Arizona State", Value: 6500, Rank: 3, Year: 2026},
-		{Name: "Carnell Tate", Position: "WR", College: "Ohio State", Value: 6200, Rank: 4, Year: 2026},
-		{Name: "Makai Lemon", Position: "WR", College: "USC", Value: 6000, Rank: 5, Year: 2026},
-		{Name: "Fernando Mendoza", Position: "QB", College: "Indiana", Value: 4000, Rank: 6, Year: 2026},
-		{Name: "Jeremiyah Love", Position: "RB", College: "Notre Dame", Value: 6800, Rank: 7, Year: 2026},
-		{Name: "Denzel Boston", Position: "WR", College: "Washington", Value: 5800, Rank: 8, Year: 2026},
-		{Name: "Justice Haynes", Position: "RB", College: "Michigan", Value: 5500, Rank: 9, Year: 2026},
-		{Name: "Chris Brazzell II", Position: "WR", College: "Tennessee", Value: 5500, Rank: 10, Year: 2026},
-		{Name: "Jonah Coleman", Position: "RB", College: "Washington", Value: 5200, Rank: 11, Year: 2026},
-		{Name: "KC Concepcion", Position: "WR", College: "Texas A&M", Value: 5000, Rank: 12, Year: 2026},
-		{Name: "Kenyon Sadiq", Position: "TE", College: "Oregon", Value: 3800, Rank: 13, Year: 2026},
-		{Name: "Nick Singleton", Position: "RB", College: "Penn State", Value: 4800, Rank: 14, Year: 2026},
-		{Name: "Eli Stowers", Position: "TE", College: "Vanderbilt", Value: 3500, Rank: 15, Year: 2026},
-	}
-}
-
-func calculatePowerRankings(teamAges []TeamAgeData) []PowerRanking {
-	rankings := []PowerRanking{}
-
-	// Create power rankings from team data
-	for _, team := range teamAges {
-		// Determine strategy based on age and record
-		strategy := "Contending"
-		if team.AvgAge > 27.0 {
-			strategy = "Win Now"
-		} else if team.AvgAge < 24.5 {
-			strategy = "Rebuilding"
-		}
-
-		// Get wins/losses from rank (Rank 1 = most wins)
-		wins := 15 - team.Rank // Approximate
-		losses := team.Rank - 1
-
-		rankings = append(rankings, PowerRanking{
-			TeamName:     team.TeamName,
-			RosterValue:  team.RosterValue,
-			Wins:         wins,
-			Losses:       losses,
-			AvgAge:       team.AvgAge,
-			Strategy:     strategy,
-			IsUserTeam:   team.IsUserTeam,
-			StandingRank: team.Rank,
-		})
-	}
-
-	// Sort by roster value (highest first) and assign value ranks
-	sort.Slice(rankings, func(i, j int) bool {
-		return rankings[i].RosterValue > rankings[j].RosterValue
-	})
-
-	for i := range rankings {
-		rankings[i].ValueRank = i + 1
-		// Overall rank is average of value rank and standing rank
-		rankings[i].Rank = (rankings[i].ValueRank + rankings[i].StandingRank) / 2
-	}
-
-	// Re-sort by combined rank
-	sort.Slice(rankings, func(i, j int) bool {
-		return rankings[i].Rank < rankings[j].Rank
-	})
-
-	return rankings
-}
-
 func calculatePositionalKTC(rows []PlayerRow) PositionalKTC {
 	posKTC := PositionalKTC{}
 	for _, row := range rows {
@@ -612,7 +519,10 @@ func formatTimeAgo(t time.Time) string {
 	return fmt.Sprintf("%d days ago", int(diff.Hours()/24))
 }
 
-func calculateProjectedDraftPicks(draftPicks []DraftPick, teamAges []TeamAgeData, targetYear int) []ProjectedDraftPick {
+// calculateProjectedDraftPicks places the user's picks for targetYear. With a draft order projection
+// each pick takes its original owner's most likely slot and carries the full slot distribution;
+// without one the slot follows current standings.
+func calculateProjectedDraftPicks(draftPicks []DraftPick, teamAges []TeamAgeData, targetYear int, order *draftOrderProjection) []ProjectedDraftPick {
 	projectedPicks := []ProjectedDraftPick{}
 
 	// Create a map of roster ID to team standing/data
@@ -621,8 +531,11 @@ func calculateProjectedDraftPicks(draftPicks []DraftPick, teamAges []TeamAgeData
 		rosterToStanding[team.RosterID] = team
 	}
 
-	// Get league size from team count
+	// Get league size from the projection, or the team count
 	leagueSize := len(teamAges)
+	if order != nil && order.Teams > 0 {
+		leagueSize = order.Teams
+	}
 
 	debugLog("[DEBUG] Calculating projected draft picks for year %d with %d teams", targetYear, leagueSize)
 
@@ -658,18 +571,18 @@ func calculateProjectedDraftPicks(draftPicks []DraftPick, teamAges []TeamAgeData
 
 		// Calculate projected position
 		// In dynasty drafts, worst team (highest rank) gets pick 1.01
-		// Rank 1 = best team (most wins) -> last pick
-		// Rank 12 = worst team (fewest wins) -> first pick
+		// Rank 1 = best team in the standings -> last pick
+		// Rank 12 = worst team in the standings -> first pick
 		projectedPosition := leagueSize - pickPositionTeam.Rank + 1
+		slotOdds := order.distribution(pickPositionTeam.RosterID)
+		if slot := order.likelySlot(pickPositionTeam.RosterID); slot > 0 {
+			projectedPosition = slot
+		}
 
 		// Calculate overall pick number
 		overallPick := (pick.Round-1)*leagueSize + projectedPosition
 
-		// Format team record
-		// Rank 1 = most wins, so approximate wins/losses
-		wins := leagueSize - pickPositionTeam.Rank
-		losses := pickPositionTeam.Rank - 1
-		teamRecord := fmt.Sprintf("%d-%d", wins, losses)
+		teamRecord := formatRecord(pickPositionTeam.Wins, pickPositionTeam.Losses, pickPositionTeam.Ties)
 
 		projectedPicks = append(projectedPicks, ProjectedDraftPick{
 			Year:              pick.Year,
@@ -681,6 +594,7 @@ func calculateProjectedDraftPicks(draftPicks []DraftPick, teamAges []TeamAgeData
 			CurrentStanding:   pickPositionTeam.Rank,
 			TeamRecord:        teamRecord,
 			IsYours:           pick.IsYours,
+			SlotOdds:          slotOdds,
 		})
 
 		debugLog("[DEBUG] Projected pick: Round %d, Position %d (Overall %d), Owner: %s, Original: %s, Current Standing: %d (%s)",
@@ -0,0 +1,253 @@
+// ABOUTME: League FAAB price model learned from completed waiver claims
+// ABOUTME: Prices bids by position, tier, and week from this season and last season's history
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	FAAB_HISTORY_WEEKS       = 18  // Waiver legs scanned for a completed season
+	FAAB_MIN_MARKET_SAMPLES  = 3.0 // Weighted comparable bids needed before the market is trusted
+	FAAB_PRIOR_SEASON_WEIGHT = 0.6 // Last season's bids count for less than this season's
+)
+
+// parseFAABBids extracts winning FAAB claims from raw Sleeper transactions.
+// Bids are stored as a percent of the league budget so seasons with different budgets compare.
+func parseFAABBids(txns []map[string]interface{}, season string, budget int, players map[string]interface{}, tiers map[string][][]string) []FAABBid {
+	if budget <= 0 {
+		return nil
+	}
+	bids := []FAABBid{}
+	for _, txn := range txns {
+		if t, _ := txn["type"].(string); t != "waiver" {
+			continue
+		}
+		if status, _ := txn["status"].(string); status != "complete" {
+			continue
+		}
+		settings, _ := txn["settings"].(map[string]interface{})
+		amount, ok := settings["waiver_bid"].(float64)
+		if !ok {
+			continue
+		}
+		adds, _ := txn["adds"].(map[string]interface{})
+		leg, _ := txn["leg"].(float64)
+		for pid := range adds {
+			p, ok := players[pid].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pos, _ := p["position"].(string)
+			if pos == "" {
+				continue
+			}
+			name := getPlayerName(p)
+			bid := FAABBid{
+				Season:     season,
+				Week:       int(leg),
+				PlayerName: name,
+				Position:   pos,
+				Amount:     int(amount),
+				BidPct:     amount / float64(budget) * 100,
+			}
+			if tiers != nil {
+				lookupPos := pos
+				if lookupPos == "DEF" {
+					lookupPos = "DST"
+				}
+				bid.Tier = findTier(tiers[lookupPos], name)
+			}
+			bids = append(bids, bid)
+		}
+	}
+	return bids
+}
+
+// fetchFAABHistory collects winning bids from the current season and the previous season.
+// Tiers only describe current players, so last season's bids are matched on position and week alone.
+func fetchFAABHistory(league map[string]interface{}, leagueID string, week int, players map[string]interface{}, tiers map[string][][]string) []FAABBid {
+	bids := []FAABBid{}
+	season, _ := league["season"].(string)
+
+	if budget := leagueFAABBudget(league); budget > 0 && week > 0 {
+		weeks := []int{}
+		for w := 1; w <= week; w++ {
+			weeks = append(weeks, w)
+		}
+		for _, txns := range fetchTransactionsForWeeks(leagueID, weeks) {
+			bids = append(bids, parseFAABBids(txns, season, budget, players, tiers)...)
+		}
+	}
+
+	prevID, _ := league["previous_league_id"].(string)
+	if prevID == "" || prevID == "0" {
+		return bids
+	}
+	prev, err := appProvider.FetchLeague(prevID)
+	if err != nil || prev == nil {
+		debugLog("[DEBUG] Could not fetch previous league %s: %v", prevID, err)
+		return bids
+	}
+	if budget := leagueFAABBudget(prev); budget > 0 {
+		prevSeason, _ := prev["season"].(string)
+		weeks := []int{}
+		for w := 1; w <= FAAB_HISTORY_WEEKS; w++ {
+			weeks = append(weeks, w)
+		}
+		for _, txns := range fetchTransactionsForWeeks(prevID, weeks) {
+			bids = append(bids, parseFAABBids(txns, prevSeason, budget, players, nil)...)
+		}
+	}
+	debugLog("[DEBUG] Collected %d winning FAAB bids for league %s", len(bids), leagueID)
+	return bids
+}
+
+// leagueFAABBudget returns the FAAB budget when the league runs FAAB waivers, else 0
+func leagueFAABBudget(league map[string]interface{}) int {
+	settings, _ := league["settings"].(map[string]interface{})
+	waiverType, _ := settings["waiver_type"].(float64)
+	budget, _ := settings["waiver_budget"].(float64)
+	if int(waiverType) != WAIVER_TYPE_FAAB {
+		return 0
+	}
+	return int(budget)
+}
+
+// buildFAABMarket summarizes winning bids by position
+func buildFAABMarket(bids []FAABBid, currentSeason string) FAABMarket {
+	market := FAABMarket{Bids: bids, CurrentSeason: currentSeason}
+	byPos := make(map[string][]float64)
+	seasons := make(map[string]bool)
+	for _, b := range bids {
+		byPos[b.Position] = append(byPos[b.Position], b.BidPct)
+		seasons[b.Season] = true
+	}
+	market.Seasons = len(seasons)
+	for pos, pcts := range byPos {
+		sort.Float64s(pcts)
+		market.Positions = append(market.Positions, FAABPositionPrice{
+			Position:  pos,
+			Samples:   len(pcts),
+			MedianPct: math.Round(percentileSorted(pcts, 0.5)*10) / 10,
+			TopPct:    math.Round(pcts[len(pcts)-1]*10) / 10,
+		})
+	}
+	sort.Slice(market.Positions, func(i, j int) bool {
+		if market.Positions[i].Samples != market.Positions[j].Samples {
+			return market.Positions[i].Samples > market.Positions[j].Samples
+		}
+		return market.Positions[i].Position < market.Positions[j].Position
+	})
+	return market
+}
+
+// weekPhase buckets waiver weeks into early, mid, and late season
+func weekPhase(week int) int {
+	switch {
+	case week <= 4:
+		return 0
+	case week <= 10:
+		return 1
+	default:
+		return 2
+	}
+}
+
+type weightedBid struct {
+	pct    float64
+	weight float64
+}
+
+// comparableBids weights past bids at a position by tier distance, season phase, and season
+func (m FAABMarket) comparableBids(pos string, tier, week int) []weightedBid {
+	out := []weightedBid{}
+	for _, b := range m.Bids {
+		if b.Position != pos {
+			continue
+		}
+		w := 1.0
+		if tier > 0 && b.Tier > 0 {
+			w *= 1 / (1 + math.Abs(float64(tier-b.Tier)))
+		} else {
+			w *= 0.5
+		}
+		if weekPhase(b.Week) != weekPhase(week) {
+			w *= 0.5
+		}
+		if b.Season != m.CurrentSeason {
+			w *= FAAB_PRIOR_SEASON_WEIGHT
+		}
+		out = append(out, weightedBid{pct: b.BidPct, weight: w})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].pct < out[j].pct
+	})
+	return out
+}
+
+// estimateBid prices a claim from comparable winning bids. The target percentile rises with
+// the claim's priority; win probability is the share of comparable prices at or below the bid.
+func (m FAABMarket) estimateBid(pos string, tier, week int, priority string, b WaiverBudget) (FAABEstimate, bool) {
+	comps := m.comparableBids(pos, tier, week)
+	total := 0.0
+	for _, c := range comps {
+		total += c.weight
+	}
+	if total < FAAB_MIN_MARKET_SAMPLES || b.Budget <= 0 {
+		return FAABEstimate{}, false
+	}
+
+	target := 0.5
+	switch priority {
+	case "High":
+		target = 0.75
+	case "Low":
+		target = 0.3
+	}
+	toDollars := func(pct float64) int {
+		return int(math.Round(pct / 100 * float64(b.Budget)))
+	}
+
+	est := FAABEstimate{
+		Samples: len(comps),
+		Low:     toDollars(weightedPercentile(comps, total, 0.25)),
+		High:    toDollars(weightedPercentile(comps, total, 0.75)),
+		Bid:     toDollars(weightedPercentile(comps, total, target)),
+	}
+	if est.Bid > b.UserRemaining {
+		est.Bid = b.UserRemaining
+	}
+
+	wins := 0.0
+	for _, c := range comps {
+		if toDollars(c.pct) <= est.Bid {
+			wins += c.weight
+		}
+	}
+	est.WinProbability = math.Round(wins / total * 100)
+	return est, true
+}
+
+// weightedPercentile returns the value where cumulative weight first reaches q of the total
+func weightedPercentile(sorted []weightedBid, total, q float64) float64 {
+	cum := 0.0
+	for _, c := range sorted {
+		cum += c.weight
+		if cum >= q*total {
+			return c.pct
+		}
+	}
+	return sorted[len(sorted)-1].pct
+}
+
+// percentileSorted returns the q-quantile of an ascending slice
+func percentileSorted(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Round(q * float64(len(sorted)-1)))
+	return sorted[idx]
+}
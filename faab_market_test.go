@@ -0,0 +1,115 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func faabTxn(pid string, bid float64, leg float64, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "waiver",
+		"status":   status,
+		"leg":      leg,
+		"adds":     map[string]interface{}{pid: float64(1)},
+		"settings": map[string]interface{}{"waiver_bid": bid},
+	}
+}
+
+func TestParseFAABBids(t *testing.T) {
+	players := map[string]interface{}{
+		"rb1": map[string]interface{}{"first_name": "Run", "last_name": "Back", "position": "RB"},
+	}
+	tiers := map[string][][]string{"RB": {{"Someone"}, {"Run Back"}}}
+	txns := []map[string]interface{}{
+		faabTxn("rb1", 25, 3, "complete"),
+		faabTxn("rb1", 40, 3, "failed"),
+		{"type": "free_agent", "status": "complete", "adds": map[string]interface{}{"rb1": float64(1)}},
+	}
+	bids := parseFAABBids(txns, "2026", 200, players, tiers)
+	if len(bids) != 1 {
+		t.Fatalf("expected one winning bid, got %+v", bids)
+	}
+	b := bids[0]
+	if b.Amount != 25 || b.BidPct != 12.5 || b.Tier != 2 || b.Week != 3 || b.Position != "RB" {
+		t.Fatalf("unexpected bid: %+v", b)
+	}
+}
+
+func TestFAABMarketEstimateBid(t *testing.T) {
+	bids := []FAABBid{}
+	for _, pct := range []float64{2, 5, 8, 10, 15, 20, 30, 40} {
+		bids = append(bids, FAABBid{Season: "2026", Week: 6, Position: "WR", Tier: 5, BidPct: pct})
+	}
+	bids = append(bids, FAABBid{Season: "2026", Week: 6, Position: "QB", Tier: 5, BidPct: 90})
+	market := buildFAABMarket(bids, "2026")
+	if market.Seasons != 1 || len(market.Positions) != 2 || market.Positions[0].Position != "WR" {
+		t.Fatalf("unexpected market summary: %+v", market.Positions)
+	}
+
+	budget := WaiverBudget{IsFAAB: true, Budget: 100, UserRemaining: 100}
+	high, ok := market.estimateBid("WR", 5, 7, "High", budget)
+	if !ok {
+		t.Fatalf("expected a market estimate")
+	}
+	low, _ := market.estimateBid("WR", 5, 7, "Low", budget)
+	if high.Bid <= low.Bid || high.WinProbability <= low.WinProbability {
+		t.Fatalf("expected high priority to bid more and win more: high=%+v low=%+v", high, low)
+	}
+	if high.Low > high.High || high.Low < 2 || high.High > 40 {
+		t.Fatalf("unexpected confidence range: %+v", high)
+	}
+
+	poor := budget
+	poor.UserRemaining = 4
+	capped, _ := market.estimateBid("WR", 5, 7, "High", poor)
+	if capped.Bid != 4 || capped.WinProbability >= high.WinProbability {
+		t.Fatalf("expected capped bid to lower win probability: %+v", capped)
+	}
+
+	if _, ok := market.estimateBid("TE", 5, 7, "High", budget); ok {
+		t.Fatalf("expected no estimate without comparable bids")
+	}
+}
+
+func TestFetchFAABHistoryIncludesPreviousSeason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/league/prev":
+			_, _ = w.Write([]byte(`{"season":"2025","settings":{"waiver_type":2,"waiver_budget":100}}`))
+		case r.URL.Path == "/league/prev/transactions/4":
+			_, _ = w.Write([]byte(`[{"type":"waiver","status":"complete","leg":4,"adds":{"wr1":1},"settings":{"waiver_bid":30}}]`))
+		case r.URL.Path == "/league/cur/transactions/1":
+			_, _ = w.Write([]byte(`[{"type":"waiver","status":"complete","leg":1,"adds":{"wr1":1},"settings":{"waiver_bid":50}}]`))
+		case strings.Contains(r.URL.Path, "/transactions/"):
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	p := NewSleeperProvider(server.Client())
+	p.baseURL = server.URL
+	saved := appProvider
+	appProvider = p
+	defer func() { appProvider = saved }()
+
+	league := map[string]interface{}{
+		"season":             "2026",
+		"previous_league_id": "prev",
+		"settings":           map[string]interface{}{"waiver_type": float64(2), "waiver_budget": float64(200)},
+	}
+	players := map[string]interface{}{"wr1": map[string]interface{}{"first_name": "Wide", "last_name": "Out", "position": "WR"}}
+	bids := fetchFAABHistory(league, "cur", 2, players, nil)
+	if len(bids) != 2 {
+		t.Fatalf("expected bids from both seasons, got %+v", bids)
+	}
+	for _, b := range bids {
+		if b.BidPct != 25 && b.BidPct != 30 {
+			t.Fatalf("expected bids normalized to each season's budget, got %+v", b)
+		}
+	}
+}
@@ -111,6 +111,8 @@ func fetchDynastyValues() (map[string]DynastyValue, string) {
 
 		playerName := strings.Trim(fields[0], "\"")
 		position := strings.Trim(fields[1], "\"")
+		team := strings.Trim(fields[2], "\"")
+		draftYear, _ := strconv.Atoi(strings.Trim(fields[4], "\""))
 		value1QB, _ := strconv.Atoi(strings.Trim(fields[8], "\""))
 		value2QB, _ := strconv.Atoi(strings.Trim(fields[9], "\""))
 		date := strings.Trim(fields[10], "\"")
@@ -124,6 +126,8 @@ func fetchDynastyValues() (map[string]DynastyValue, string) {
 		values[normalizedName] = DynastyValue{
 			Name:       playerName,
 			Position:   position,
+			Team:       team,
+			DraftYear:  draftYear,
 			Value1QB:   value1QB,
 			Value2QB:   value2QB,
 			ScrapeDate: date,
@@ -248,7 +252,107 @@ func fetchBorisTiersImpl(scoring string) map[string][][]string {
 	return out
 }
 
-func fetchRecentTransactions(leagueID string, currentWeek int, players map[string]interface{}, rosters []map[string]interface{}, userNames map[string]string, dynastyValues map[string]DynastyValue, isSuperFlex bool) []Transaction {
+// parseTradeTransaction builds a two-team trade from a Sleeper transaction: players from adds,
+// picks from draft_picks, and FAAB from waiver_budget, all valued on the dynasty scale
+func parseTradeTransaction(txn map[string]interface{}, leagueID string, rosterTeams map[float64]string, players map[string]interface{}, dynastyValues map[string]DynastyValue, isSuperFlex bool, picks *pickValuer) (Transaction, bool) {
+	rosterIDs, _ := txn["roster_ids"].([]interface{})
+	if len(rosterIDs) < 2 {
+		return Transaction{}, false
+	}
+
+	roster1, _ := rosterIDs[0].(float64)
+	roster2, _ := rosterIDs[1].(float64)
+
+	team1 := rosterTeams[roster1]
+	team2 := rosterTeams[roster2]
+
+	// Determine what each team gave
+	team1Gave := []string{}
+	team2Gave := []string{}
+	gave := func(giver, receiver float64, asset string) {
+		switch {
+		case receiver == roster1 || (receiver == 0 && giver == roster2):
+			team2Gave = append(team2Gave, asset)
+		case receiver == roster2 || (receiver == 0 && giver == roster1):
+			team1Gave = append(team1Gave, asset)
+		}
+	}
+
+	// Players: adds maps player ID -> receiving roster
+	adds, _ := txn["adds"].(map[string]interface{})
+	for playerID, rosterID := range adds {
+		recipientRosterID, _ := rosterID.(float64)
+		if p, ok := players[playerID].(map[string]interface{}); ok {
+			gave(0, recipientRosterID, getPlayerName(p))
+		}
+	}
+
+	// Draft picks: roster_id is the original owner, owner_id the receiver, previous_owner_id the giver
+	draftPicks, _ := txn["draft_picks"].([]interface{})
+	for _, pickData := range draftPicks {
+		pick, _ := pickData.(map[string]interface{})
+		originalID, _ := pick["roster_id"].(float64)
+		receiverID, _ := pick["owner_id"].(float64)
+		giverID, _ := pick["previous_owner_id"].(float64)
+		season, _ := pick["season"].(string)
+		round, _ := pick["round"].(float64)
+
+		pickDesc := fmt.Sprintf("%s Round %d", season, int(round))
+		if giverID == 0 {
+			// Older transactions omit the giver: it's the other side of the trade
+			giverID = roster1
+			if receiverID == roster1 {
+				giverID = roster2
+			}
+		}
+		if originalID != giverID {
+			pickDesc = fmt.Sprintf("%s (from %s)", pickDesc, rosterTeams[originalID])
+		}
+		gave(giverID, receiverID, pickDesc)
+	}
+
+	// FAAB: waiver_budget lists each sender -> receiver transfer
+	budget, _ := txn["waiver_budget"].([]interface{})
+	for _, entry := range budget {
+		transfer, _ := entry.(map[string]interface{})
+		sender, _ := transfer["sender"].(float64)
+		receiver, _ := transfer["receiver"].(float64)
+		amount, _ := transfer["amount"].(float64)
+		if amount > 0 {
+			gave(sender, receiver, faabAsset(int(amount)))
+		}
+	}
+
+	// Calculate dynasty values if available
+	team1GaveValue := 0
+	team2GaveValue := 0
+	if dynastyValues != nil {
+		team1GaveValue = calculateAssetValue(team1Gave, dynastyValues, isSuperFlex, picks)
+		team2GaveValue = calculateAssetValue(team2Gave, dynastyValues, isSuperFlex, picks)
+	}
+
+	trade := Transaction{
+		Type:           "trade",
+		LeagueID:       leagueID,
+		Description:    fmt.Sprintf("%s traded with %s", team1, team2),
+		TeamNames:      []string{team1, team2},
+		Team1:          team1,
+		Team2:          team2,
+		Team1Gave:      team1Gave,
+		Team2Gave:      team2Gave,
+		Team1GaveValue: team1GaveValue,
+		Team2GaveValue: team2GaveValue,
+		NetValue:       team2GaveValue - team1GaveValue, // Positive means team1 gained value
+	}
+
+	// Calculate fairness (Feature #3) - only for dynasty leagues
+	if dynastyValues != nil && (team1GaveValue > 0 || team2GaveValue > 0) {
+		trade.Fairness = calculateTradeFairness(trade, nil)
+	}
+	return trade, true
+}
+
+func fetchRecentTransactions(leagueID string, currentWeek int, players map[string]interface{}, rosters []map[string]interface{}, userNames map[string]string, dynastyValues map[string]DynastyValue, isSuperFlex bool, picks *pickValuer) []Transaction {
 	transactions := []Transaction{}
 
 	// Fetch transactions from multiple weeks (last 3 weeks)
@@ -325,131 +429,12 @@ func fetchRecentTransactions(leagueID string, currentWeek int, players map[strin
 
 		// Handle trades
 		if txnType == "trade" {
-			rosterIDs, _ := txn["roster_ids"].([]interface{})
-			if len(rosterIDs) < 2 {
+			trade, ok := parseTradeTransaction(txn, leagueID, rosterTeams, players, dynastyValues, isSuperFlex, picks)
+			if !ok {
 				continue
 			}
-
-			roster1, _ := rosterIDs[0].(float64)
-			roster2, _ := rosterIDs[1].(float64)
-
-			team1 := rosterTeams[roster1]
-			team2 := rosterTeams[roster2]
-
-			// Get draft picks involved (map of roster_id -> pick details)
-			draftPicks, _ := txn["draft_picks"].([]interface{})
-
-			// Get players involved
-			adds, _ := txn["adds"].(map[string]interface{})
-			_, _ = txn["drops"].(map[string]interface{})
-
-			// Determine what each team gave
-			team1Gave := []string{}
-			team2Gave := []string{}
-
-			// Players
-			for playerID, rosterID := range adds {
-				recipientRosterID, _ := rosterID.(float64)
-				if p, ok := players[playerID].(map[string]interface{}); ok {
-					playerName := getPlayerName(p)
-					if recipientRosterID == roster1 {
-						team2Gave = append(team2Gave, playerName)
-					} else {
-						team1Gave = append(team1Gave, playerName)
-					}
-				}
-			}
-
-			// Draft picks
-			for _, pickData := range draftPicks {
-				pick, _ := pickData.(map[string]interface{})
-				ownerID, _ := pick["owner_id"].(float64)   // Who originally owned this pick
-				rosterID, _ := pick["roster_id"].(float64) // Who now owns this pick
-				season, _ := pick["season"].(string)
-				round, _ := pick["round"].(float64)
-
-				pickDesc := fmt.Sprintf("%s Round %d", season, int(round))
-
-				// Determine who gave this pick
-				if rosterID == roster1 {
-					// roster1 now has the pick, so roster2 (or original owner) gave it
-					if ownerID == roster2 {
-						team2Gave = append(team2Gave, pickDesc)
-					} else {
-						// Pick was traded to roster2 before, now going to roster1
-						origTeam := rosterTeams[ownerID]
-						team2Gave = append(team2Gave, fmt.Sprintf("%s (from %s)", pickDesc, origTeam))
-					}
-				} else {
-					// roster2 now has the pick, so roster1 (or original owner) gave it
-					if ownerID == roster1 {
-						team1Gave = append(team1Gave, pickDesc)
-					} else {
-						origTeam := rosterTeams[ownerID]
-						team1Gave = append(team1Gave, fmt.Sprintf("%s (from %s)", pickDesc, origTeam))
-					}
-				}
-			}
-
-			// Calculate dynasty values if available
-			team1GaveValue := 0
-			team2GaveValue := 0
-			if dynastyValues != nil {
-				for _, playerName := range team1Gave {
-					// Skip draft picks (they contain "Round")
-					if !strings.Contains(playerName, "Round") {
-						normalizedName := normalizeName(playerName)
-						if value, exists := dynastyValues[normalizedName]; exists {
-							if isSuperFlex {
-								team1GaveValue += value.Value2QB
-							} else {
-								team1GaveValue += value.Value1QB
-							}
-						}
-					}
-				}
-				for _, playerName := range team2Gave {
-					// Skip draft picks
-					if !strings.Contains(playerName, "Round") {
-						normalizedName := normalizeName(playerName)
-						if value, exists := dynastyValues[normalizedName]; exists {
-							if isSuperFlex {
-								team2GaveValue += value.Value2QB
-							} else {
-								team2GaveValue += value.Value1QB
-							}
-						}
-					}
-				}
-			}
-
-			// Net value: positive means team1 gained value (got more than they gave)
-			netValue := team2GaveValue - team1GaveValue
-
-			// Build description
-			desc := fmt.Sprintf("%s traded with %s", team1, team2)
-
-			txn := Transaction{
-				Type:           "trade",
-				LeagueID:       leagueID,
-				Timestamp:      timestamp,
-				Description:    desc,
-				TeamNames:      []string{team1, team2},
-				Team1:          team1,
-				Team2:          team2,
-				Team1Gave:      team1Gave,
-				Team2Gave:      team2Gave,
-				Team1GaveValue: team1GaveValue,
-				Team2GaveValue: team2GaveValue,
-				NetValue:       netValue,
-			}
-
-			// Calculate fairness (Feature #3) - only for dynasty leagues
-			if dynastyValues != nil && (team1GaveValue > 0 || team2GaveValue > 0) {
-				txn.Fairness = calculateTradeFairness(txn, nil)
-			}
-
-			transactions = append(transactions, txn)
+			trade.Timestamp = timestamp
+			transactions = append(transactions, trade)
 		} else if txnType == "waiver" || txnType == "free_agent" {
 			// Handle waivers and free agent pickups
 			rosterIDs, _ := txn["roster_ids"].([]interface{})
@@ -519,3 +504,143 @@ func fetchRecentTransactions(leagueID string, currentWeek int, players map[strin
 
 	return transactions
 }
+
+// fetchMatchupsForWeeks returns matchups for each requested week, fetching uncached weeks concurrently
+func fetchMatchupsForWeeks(leagueID string, weeks []int) map[int][]map[string]interface{} {
+	return fetchWeeksCached(leagueMatchupsCache, "matchups", leagueID, weeks, appProvider.FetchLeagueMatchups)
+}
+
+// fetchTransactionsForWeeks returns transactions for each requested week, fetching uncached weeks concurrently
+func fetchTransactionsForWeeks(leagueID string, weeks []int) map[int][]map[string]interface{} {
+	return fetchWeeksCached(leagueTransactionsCache, "transactions", leagueID, weeks, appProvider.FetchLeagueTransactions)
+}
+
+// fetchWeeksCached serves per-week league data from cache and fetches the missing weeks concurrently
+func fetchWeeksCached(cache *matchupsCache, kind, leagueID string, weeks []int, fetch func(string, int) ([]map[string]interface{}, error)) map[int][]map[string]interface{} {
+	result := make(map[int][]map[string]interface{})
+	missing := []int{}
+
+	cache.RLock()
+	for _, w := range weeks {
+		key := fmt.Sprintf("%s:%d", leagueID, w)
+		if data, ok := cache.data[key]; ok && time.Since(cache.timestamp[key]) < cache.ttl {
+			result[w] = data
+		} else {
+			missing = append(missing, w)
+		}
+	}
+	cache.RUnlock()
+
+	if len(missing) == 0 {
+		return result
+	}
+	debugLog("[DEBUG] Fetching %s for league %s weeks %v", kind, leagueID, missing)
+
+	type weekData struct {
+		week int
+		data []map[string]interface{}
+		err  error
+	}
+	results := make(chan weekData, len(missing))
+	for _, w := range missing {
+		go func(w int) {
+			data, err := fetch(leagueID, w)
+			results <- weekData{week: w, data: data, err: err}
+		}(w)
+	}
+
+	fetched := []weekData{}
+	for range missing {
+		r := <-results
+		if r.err != nil {
+			debugLog("[DEBUG] Error fetching %s for week %d: %v", kind, r.week, r.err)
+			continue
+		}
+		fetched = append(fetched, r)
+	}
+
+	cache.Lock()
+	for _, r := range fetched {
+		result[r.week] = r.data
+		key := fmt.Sprintf("%s:%d", leagueID, r.week)
+		cache.data[key] = r.data
+		cache.timestamp[key] = time.Now()
+	}
+	cache.Unlock()
+
+	return result
+}
+
+// fetchNFLByeWeeks returns each NFL team's bye week for a season from Sleeper's schedule.
+// Failures are cached briefly so an outage doesn't log an error on every lookup.
+func fetchNFLByeWeeks(season string) map[string]int {
+	nflByeWeeksCache.RLock()
+	if data, ok := nflByeWeeksCache.data[season]; ok {
+		ttl := nflByeWeeksCache.ttl
+		if data == nil {
+			ttl = nflByeWeeksCache.failureTTL
+		}
+		if time.Since(nflByeWeeksCache.timestamp[season]) < ttl {
+			nflByeWeeksCache.RUnlock()
+			return data
+		}
+	}
+	nflByeWeeksCache.RUnlock()
+
+	var byes map[string]int
+	games, err := appProvider.FetchNFLSchedule(season)
+	if err != nil {
+		log.Printf("[ERROR] Error fetching NFL schedule for %s: %v", season, err)
+		totalErrors.Inc()
+	} else {
+		byes = byeWeeksFromSchedule(games)
+		debugLog("[DEBUG] Loaded bye weeks for %d NFL teams (%s)", len(byes), season)
+	}
+
+	nflByeWeeksCache.Lock()
+	nflByeWeeksCache.data[season] = byes
+	nflByeWeeksCache.timestamp[season] = time.Now()
+	nflByeWeeksCache.Unlock()
+	return byes
+}
+
+// byeWeeksFromSchedule finds the week each team is missing from the regular-season schedule
+func byeWeeksFromSchedule(games []map[string]interface{}) map[string]int {
+	playing := make(map[int]map[string]bool)
+	teams := make(map[string]bool)
+	maxWeek := 0
+	for _, g := range games {
+		week, ok := g["week"].(float64)
+		if !ok {
+			continue
+		}
+		w := int(week)
+		if playing[w] == nil {
+			playing[w] = make(map[string]bool)
+		}
+		for _, side := range []string{"home", "away"} {
+			if team, ok := g[side].(string); ok && team != "" {
+				playing[w][team] = true
+				teams[team] = true
+			}
+		}
+		if w > maxWeek {
+			maxWeek = w
+		}
+	}
+
+	byes := make(map[string]int)
+	for w := 1; w <= maxWeek; w++ {
+		if len(playing[w]) == 0 {
+			continue
+		}
+		for team := range teams {
+			if !playing[w][team] {
+				if _, seen := byes[team]; !seen {
+					byes[team] = w
+				}
+			}
+		}
+	}
+	return byes
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseTradeTransactionValuesPicksAndFAAB(t *testing.T) {
+	teams := map[float64]string{1: "Team One", 2: "Team Two", 3: "Team Three"}
+	players := map[string]interface{}{
+		"p1": map[string]interface{}{"first_name": "Player", "last_name": "A", "position": "WR"},
+	}
+	values := map[string]DynastyValue{normalizeName("Player A"): {Value1QB: 3000}}
+	picks := newPickValuer(nil, false, 12, 2026)
+	picks.faabBudget = 100
+
+	txn := map[string]interface{}{
+		"type":       "trade",
+		"roster_ids": []interface{}{float64(1), float64(2)},
+		"adds":       map[string]interface{}{"p1": float64(2)},
+		"draft_picks": []interface{}{
+			map[string]interface{}{"season": "2026", "round": float64(1), "roster_id": float64(1), "owner_id": float64(2), "previous_owner_id": float64(1)},
+			map[string]interface{}{"season": "2027", "round": float64(2), "roster_id": float64(3), "owner_id": float64(1), "previous_owner_id": float64(2)},
+		},
+		"waiver_budget": []interface{}{
+			map[string]interface{}{"sender": float64(2), "receiver": float64(1), "amount": float64(20)},
+		},
+	}
+	trade, ok := parseTradeTransaction(txn, "L1", teams, players, values, false, picks)
+	if !ok {
+		t.Fatalf("expected the trade to parse")
+	}
+	if fmt.Sprint(trade.Team1Gave) != "[Player A 2026 Round 1]" {
+		t.Fatalf("unexpected team 1 side: %v", trade.Team1Gave)
+	}
+	if fmt.Sprint(trade.Team2Gave) != "[2027 Round 2 (from Team Three) $20 FAAB]" {
+		t.Fatalf("unexpected team 2 side: %v", trade.Team2Gave)
+	}
+
+	team1 := 3000 + picks.value(2026, 1, 0)
+	team2 := picks.value(2027, 2, 0) + picks.faabValue(20)
+	if trade.Team1GaveValue != team1 || trade.Team2GaveValue != team2 || trade.NetValue != team2-team1 {
+		t.Fatalf("expected picks and FAAB in the values: got %d / %d, want %d / %d", trade.Team1GaveValue, trade.Team2GaveValue, team1, team2)
+	}
+	if trade.Fairness.Winner != "Team2" || trade.Fairness.ValueDelta != team1-team2 {
+		t.Fatalf("expected fairness on the full trade: %+v", trade.Fairness)
+	}
+
+	// Without a giver, a pick comes from the side that didn't receive it
+	delete(txn["draft_picks"].([]interface{})[0].(map[string]interface{}), "previous_owner_id")
+	trade, _ = parseTradeTransaction(txn, "L1", teams, players, values, false, picks)
+	if fmt.Sprint(trade.Team1Gave) != "[Player A 2026 Round 1]" {
+		t.Fatalf("unexpected team 1 side without a giver: %v", trade.Team1Gave)
+	}
+}
+
+func TestFetchNFLByeWeeksCachesFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/schedule/nfl/regular/1999" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	p := NewSleeperProvider(server.Client())
+	p.scheduleURL = server.URL
+	saved := appProvider
+	appProvider = p
+	defer func() { appProvider = saved }()
+	defer func() {
+		nflByeWeeksCache.Lock()
+		delete(nflByeWeeksCache.data, "1999")
+		delete(nflByeWeeksCache.timestamp, "1999")
+		nflByeWeeksCache.Unlock()
+	}()
+
+	if byes := fetchNFLByeWeeks("1999"); byes != nil {
+		t.Fatalf("expected no bye weeks from a failed fetch, got %v", byes)
+	}
+	fetchNFLByeWeeks("1999")
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected the failure to be cached, got %d requests", n)
+	}
+}
@@ -0,0 +1,390 @@
+// ABOUTME: Free-agent explorer listing every unrostered player in a league
+// ABOUTME: Supports position, tier, age, value, team, injury, and bye filters with sorting and paging
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const FREE_AGENT_PAGE_SIZE = 100
+
+// fantasyPositions are the positions the explorer lists
+var fantasyPositions = []string{"QB", "RB", "WR", "TE", "K", "DEF"}
+
+// FreeAgentFilter holds explorer filters parsed from the query string (zero values mean "any")
+type FreeAgentFilter struct {
+	Position string
+	MaxTier  int
+	MaxAge   int
+	MinValue int
+	Team     string
+	Injury   string // "healthy" or "injured"
+	ByeWeek  int
+	Query    string
+	Sort     string // "tier", "value", "age", "roster", "trending", "name"
+	Page     int
+}
+
+// FreeAgentsPage is the explorer view (also served as JSON with format=json)
+type FreeAgentsPage struct {
+	LeagueID   string
+	LeagueName string
+	Season     string
+	Scoring    string
+	IsDynasty  bool
+	Filter     FreeAgentFilter
+	Players    []PlayerRow
+	Total      int // Players matching the filters
+	Pool       int // Every unrostered player
+	Page       int
+	Pages      int
+	Positions  []string
+	Teams      []string
+	PrevURL    string
+	NextURL    string
+}
+
+// isFantasyPosition reports whether the explorer lists a position
+func isFantasyPosition(pos string) bool {
+	for _, p := range fantasyPositions {
+		if p == pos {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRosteredSet collects every player on any roster, including IR and taxi
+func buildRosteredSet(rosters []map[string]interface{}) map[string]bool {
+	rostered := map[string]bool{}
+	for _, r := range rosters {
+		for _, key := range []string{"players", "reserve", "taxi"} {
+			for _, pid := range toStringSlice(r[key]) {
+				rostered[pid] = true
+			}
+		}
+	}
+	return rostered
+}
+
+// leagueScoringFormat maps the league's reception scoring to a Boris Chen format
+func leagueScoringFormat(league map[string]interface{}) string {
+	if scoringSettings, ok := league["scoring_settings"].(map[string]interface{}); ok {
+		if rec, ok := scoringSettings["rec"].(float64); ok {
+			if rec == 0.5 {
+				return "Half PPR"
+			} else if rec == 0.0 {
+				return "Standard"
+			}
+		}
+	}
+	return "PPR"
+}
+
+// leagueIsSuperFlex reports whether the league starts a SUPER_FLEX slot
+func leagueIsSuperFlex(league map[string]interface{}) bool {
+	if rp, ok := league["roster_positions"].([]interface{}); ok {
+		for _, pos := range rp {
+			if pos == "SUPER_FLEX" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseFreeAgentFilter reads explorer filters from query parameters
+func parseFreeAgentFilter(q url.Values) FreeAgentFilter {
+	atoi := func(key string) int {
+		n, _ := strconv.Atoi(strings.TrimSpace(q.Get(key)))
+		if n < 0 {
+			return 0
+		}
+		return n
+	}
+	f := FreeAgentFilter{
+		Position: strings.ToUpper(strings.TrimSpace(q.Get("pos"))),
+		MaxTier:  atoi("max_tier"),
+		MaxAge:   atoi("max_age"),
+		MinValue: atoi("min_value"),
+		Team:     strings.ToUpper(strings.TrimSpace(q.Get("team"))),
+		Injury:   strings.ToLower(strings.TrimSpace(q.Get("injury"))),
+		ByeWeek:  atoi("bye"),
+		Query:    strings.TrimSpace(q.Get("q")),
+		Sort:     strings.ToLower(strings.TrimSpace(q.Get("sort"))),
+		Page:     atoi("page"),
+	}
+	if f.Position == "DST" {
+		f.Position = "DEF"
+	}
+	if f.Sort == "" {
+		f.Sort = "tier"
+	}
+	if f.Page < 1 {
+		f.Page = 1
+	}
+	return f
+}
+
+// buildFreeAgentPool turns every unrostered, active fantasy player into a row
+func buildFreeAgentPool(players map[string]interface{}, rostered map[string]bool, tiers map[string][][]string, dynastyValues map[string]DynastyValue, isSuperFlex bool, byes map[string]int, adds, drops map[string]int) []PlayerRow {
+	pool := []PlayerRow{}
+	for pid, p := range players {
+		if rostered[pid] {
+			continue
+		}
+		pm, ok := p.(map[string]interface{})
+		if !ok || pm["active"] == false {
+			continue
+		}
+		pos, _ := pm["position"].(string)
+		if !isFantasyPosition(pos) {
+			continue
+		}
+		name := getPlayerName(pm)
+		lookupPos := pos
+		if lookupPos == "DEF" {
+			lookupPos = "DST"
+		}
+		team, _ := pm["team"].(string)
+		injury, _ := pm["injury_status"].(string)
+		age := 0
+		if v, ok := pm["age"].(float64); ok {
+			age = int(v)
+		}
+		percent := 0.0
+		if v, ok := pm["roster_percent"].(float64); ok {
+			percent = v
+		} else if v, ok := pm["roster_percent"].(string); ok {
+			percent, _ = strconv.ParseFloat(v, 64)
+		}
+		row := PlayerRow{
+			PlayerID:      pid,
+			Pos:           pos,
+			RealPos:       pos,
+			Name:          name,
+			Tier:          findTier(tiers[lookupPos], name),
+			IsFreeAgent:   true,
+			Age:           age,
+			InjuryStatus:  injury,
+			RosterPercent: percent,
+			Team:          team,
+			ByeWeek:       byes[team],
+			TrendingAdds:  adds[pid],
+			TrendingDrops: drops[pid],
+		}
+		if dynastyValues != nil {
+			row.DynastyValue = getDynastyValue(name, dynastyValues, isSuperFlex)
+		}
+		pool = append(pool, row)
+	}
+	return pool
+}
+
+// filterFreeAgents applies explorer filters to the pool
+func filterFreeAgents(pool []PlayerRow, f FreeAgentFilter) []PlayerRow {
+	query := normalizeName(f.Query)
+	out := []PlayerRow{}
+	for _, row := range pool {
+		tier := int(parseTierFloat(row.Tier))
+		switch {
+		case f.Position != "" && row.Pos != f.Position:
+			continue
+		case f.MaxTier > 0 && (tier == 0 || tier > f.MaxTier):
+			continue
+		case f.MaxAge > 0 && (row.Age == 0 || row.Age > f.MaxAge):
+			continue
+		case f.MinValue > 0 && row.DynastyValue < f.MinValue:
+			continue
+		case f.Team != "" && row.Team != f.Team:
+			continue
+		case f.Injury == "healthy" && row.InjuryStatus != "":
+			continue
+		case f.Injury == "injured" && row.InjuryStatus == "":
+			continue
+		case f.ByeWeek > 0 && row.ByeWeek != f.ByeWeek:
+			continue
+		case query != "" && !strings.Contains(normalizeName(row.Name), query):
+			continue
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+// sortFreeAgents orders rows by the chosen key; unranked and unknown values sort last
+func sortFreeAgents(rows []PlayerRow, key string) {
+	tierKey := func(r PlayerRow) int {
+		if t := int(parseTierFloat(r.Tier)); t > 0 {
+			return t
+		}
+		return 1 << 30
+	}
+	ageKey := func(r PlayerRow) int {
+		if r.Age > 0 {
+			return r.Age
+		}
+		return 1 << 30
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		switch key {
+		case "value":
+			if a.DynastyValue != b.DynastyValue {
+				return a.DynastyValue > b.DynastyValue
+			}
+		case "age":
+			if ageKey(a) != ageKey(b) {
+				return ageKey(a) < ageKey(b)
+			}
+		case "roster":
+			if a.RosterPercent != b.RosterPercent {
+				return a.RosterPercent > b.RosterPercent
+			}
+		case "trending":
+			if a.TrendingAdds-a.TrendingDrops != b.TrendingAdds-b.TrendingDrops {
+				return a.TrendingAdds-a.TrendingDrops > b.TrendingAdds-b.TrendingDrops
+			}
+		case "name":
+			return a.Name < b.Name
+		default:
+			if tierKey(a) != tierKey(b) {
+				return tierKey(a) < tierKey(b)
+			}
+		}
+		// Tiebreak: better tier, then more rostered, then name
+		if tierKey(a) != tierKey(b) {
+			return tierKey(a) < tierKey(b)
+		}
+		if a.RosterPercent != b.RosterPercent {
+			return a.RosterPercent > b.RosterPercent
+		}
+		return a.Name < b.Name
+	})
+}
+
+// explorerPageURL rebuilds the explorer URL for another page of results
+func explorerPageURL(q url.Values, page int) string {
+	next := url.Values{}
+	for k, v := range q {
+		next[k] = v
+	}
+	next.Set("page", strconv.Itoa(page))
+	return "/free-agents?" + next.Encode()
+}
+
+func freeAgentsHandler(w http.ResponseWriter, r *http.Request) {
+	trackPageView(r.URL.Path)
+	q := r.URL.Query()
+	leagueID := strings.TrimSpace(q.Get("league_id"))
+	asJSON := q.Get("format") == "json"
+	if leagueID == "" {
+		http.Error(w, "league_id is required", http.StatusBadRequest)
+		return
+	}
+
+	page, err := buildFreeAgentsPage(leagueID, parseFreeAgentFilter(q))
+	if err != nil {
+		log.Printf("[ERROR] Free agent explorer failed for league %s: %v", leagueID, err)
+		totalErrors.Inc()
+		http.Error(w, "failed to load league", http.StatusBadGateway)
+		return
+	}
+	if page.Page > 1 {
+		page.PrevURL = explorerPageURL(q, page.Page-1)
+	}
+	if page.Page < page.Pages {
+		page.NextURL = explorerPageURL(q, page.Page+1)
+	}
+
+	if asJSON {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+		return
+	}
+	if err := templates.ExecuteTemplate(w, "free_agents.html", page); err != nil {
+		log.Printf("[ERROR] Free agents template error: %v", err)
+		http.Error(w, "Error rendering free agents", http.StatusInternalServerError)
+	}
+}
+
+// buildFreeAgentsPage loads a league and builds one page of filtered free agents
+func buildFreeAgentsPage(leagueID string, filter FreeAgentFilter) (*FreeAgentsPage, error) {
+	league, err := appProvider.FetchLeague(leagueID)
+	if err != nil || league == nil || league["league_id"] == nil {
+		return nil, fmt.Errorf("league %s not found: %v", leagueID, err)
+	}
+	rosters, err := appProvider.FetchLeagueRosters(leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("rosters: %w", err)
+	}
+	players, err := fetchPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("players: %w", err)
+	}
+
+	leagueName, _ := league["name"].(string)
+	scoring := leagueScoringFormat(league)
+	isDynasty := isDynastyLeague(league)
+	season := leagueSeasonString(league)
+
+	var dynastyValues map[string]DynastyValue
+	if isDynasty {
+		dynastyValues, _ = fetchDynastyValues()
+	}
+	byes := fetchNFLByeWeeks(season)
+	pool := buildFreeAgentPool(players, buildRosteredSet(rosters), fetchBorisTiers(scoring), dynastyValues, leagueIsSuperFlex(league), byes, fetchTrendingPlayers("add"), fetchTrendingPlayers("drop"))
+
+	teams := map[string]bool{}
+	for _, row := range pool {
+		if row.Team != "" {
+			teams[row.Team] = true
+		}
+	}
+	teamList := make([]string, 0, len(teams))
+	for t := range teams {
+		teamList = append(teamList, t)
+	}
+	sort.Strings(teamList)
+
+	matches := filterFreeAgents(pool, filter)
+	sortFreeAgents(matches, filter.Sort)
+
+	pages := (len(matches) + FREE_AGENT_PAGE_SIZE - 1) / FREE_AGENT_PAGE_SIZE
+	if pages < 1 {
+		pages = 1
+	}
+	if filter.Page > pages {
+		filter.Page = pages
+	}
+	start := (filter.Page - 1) * FREE_AGENT_PAGE_SIZE
+	end := start + FREE_AGENT_PAGE_SIZE
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	debugLog("[DEBUG] Free agent explorer: %d of %d unrostered players match filters", len(matches), len(pool))
+	return &FreeAgentsPage{
+		LeagueID:   leagueID,
+		LeagueName: leagueName,
+		Season:     season,
+		Scoring:    scoring,
+		IsDynasty:  isDynasty,
+		Filter:     filter,
+		Players:    matches[start:end],
+		Total:      len(matches),
+		Pool:       len(pool),
+		Page:       filter.Page,
+		Pages:      pages,
+		Positions:  fantasyPositions,
+		Teams:      teamList,
+	}, nil
+}
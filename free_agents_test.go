@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func freeAgentTestPool() []PlayerRow {
+	players := map[string]interface{}{
+		"1": map[string]interface{}{"first_name": "Young", "last_name": "Back", "position": "RB", "team": "DET", "age": float64(22), "active": true},
+		"2": map[string]interface{}{"first_name": "Old", "last_name": "Receiver", "position": "WR", "team": "KC", "age": float64(31), "injury_status": "Out", "active": true},
+		"3": map[string]interface{}{"first_name": "Rostered", "last_name": "Guy", "position": "WR", "team": "KC", "active": true},
+		"4": map[string]interface{}{"first_name": "Retired", "last_name": "Guy", "position": "QB", "active": false},
+		"5": map[string]interface{}{"first_name": "Long", "last_name": "Snapper", "position": "LS", "team": "KC", "active": true},
+		"6": map[string]interface{}{"first_name": "Deep", "last_name": "Sleeper", "position": "WR", "team": "DET", "age": float64(24), "active": true},
+	}
+	tiers := map[string][][]string{"RB": {{"Young Back"}}, "WR": {{}, {}, {"Old Receiver"}}}
+	values := map[string]DynastyValue{
+		"youngback":   {Name: "Young Back", Value1QB: 4000},
+		"oldreceiver": {Name: "Old Receiver", Value1QB: 1500},
+	}
+	byes := map[string]int{"DET": 8, "KC": 10}
+	adds := map[string]int{"6": 900}
+	return buildFreeAgentPool(players, map[string]bool{"3": true}, tiers, values, false, byes, adds, nil)
+}
+
+func TestBuildFreeAgentPool(t *testing.T) {
+	pool := freeAgentTestPool()
+	if len(pool) != 3 {
+		t.Fatalf("expected 3 unrostered active fantasy players, got %+v", pool)
+	}
+	sortFreeAgents(pool, "tier")
+	if pool[0].Name != "Young Back" || pool[0].Tier != 1 || pool[0].ByeWeek != 8 || pool[0].PlayerID != "1" {
+		t.Fatalf("unexpected top row: %+v", pool[0])
+	}
+	if pool[2].Name != "Deep Sleeper" {
+		t.Fatalf("expected unranked player last, got %+v", pool)
+	}
+}
+
+func TestFilterFreeAgents(t *testing.T) {
+	pool := freeAgentTestPool()
+	cases := []struct {
+		query string
+		want  int
+	}{
+		{"pos=WR", 2},
+		{"max_tier=2", 1},
+		{"max_age=25", 2},
+		{"team=det&injury=healthy", 2},
+		{"injury=injured", 1},
+		{"bye=10", 1},
+		{"q=sleep", 1},
+	}
+	for _, c := range cases {
+		q, _ := url.ParseQuery(c.query)
+		if got := filterFreeAgents(pool, parseFreeAgentFilter(q)); len(got) != c.want {
+			t.Fatalf("%s: expected %d players, got %+v", c.query, c.want, got)
+		}
+	}
+}
+
+func TestSortFreeAgents(t *testing.T) {
+	pool := freeAgentTestPool()
+	sortFreeAgents(pool, "age")
+	if pool[0].Name != "Young Back" || pool[2].Name != "Old Receiver" {
+		t.Fatalf("unexpected age order: %+v", pool)
+	}
+	sortFreeAgents(pool, "trending")
+	if pool[0].Name != "Deep Sleeper" {
+		t.Fatalf("expected trending player first, got %+v", pool)
+	}
+	if f := parseFreeAgentFilter(url.Values{}); f.Sort != "tier" || f.Page != 1 {
+		t.Fatalf("unexpected default filter: %+v", f)
+	}
+}
+
+func TestFreeAgentsHandlerRequiresLeague(t *testing.T) {
+	rec := httptest.NewRecorder()
+	freeAgentsHandler(rec, httptest.NewRequest(http.MethodGet, "/free-agents", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without league_id, got %d", rec.Code)
+	}
+}
@@ -436,16 +436,7 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		isDynasty := isDynastyLeague(league)
 
 		// Determine scoring type
-		scoring := "PPR"
-		if scoringSettings, ok := league["scoring_settings"].(map[string]interface{}); ok {
-			if rec, ok := scoringSettings["rec"].(float64); ok {
-				if rec == 0.5 {
-					scoring = "Half PPR"
-				} else if rec == 0.0 {
-					scoring = "Standard"
-				}
-			}
-		}
+		scoring := leagueScoringFormat(league)
 
 		// Debug: Check league roster_positions
 		if rosterPositions, ok := league["roster_positions"].([]interface{}); ok {
@@ -492,7 +483,9 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		starters := toStringSlice(userRoster["starters"])
 		allPlayers := toStringSlice(userRoster["players"])
 		irPlayers := toStringSlice(userRoster["reserve"])
-		bench := diff(allPlayers, starters)
+		taxiPlayers := toStringSlice(userRoster["taxi"])
+		// Taxi squad players are listed in "players" but can't be started, so keep them off the bench
+		bench := diff(diff(allPlayers, starters), taxiPlayers)
 		// Add IR players to bench if not already present
 		for _, ir := range irPlayers {
 			found := false
@@ -649,12 +642,21 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		}
 		debugLog("[DEBUG] League is superflex: %v", isSuperFlex)
 
+		// Build taxi squad rows (dynasty leagues only)
+		var taxiRows, taxiUnrankedRows []PlayerRow
+		if len(taxiPlayers) > 0 {
+			taxiRows, taxiUnrankedRows, _ = buildRowsWithPositions(taxiPlayers, players, borisTiers, false, nil, nil, nil)
+			debugLog("[DEBUG] Built taxiRows: %v", taxiRows)
+		}
+
 		// Enrich rows with dynasty values (if this is a dynasty league)
 		if isDynasty && dynastyValues != nil {
 			enrichRowsWithDynastyValues(startersRows, dynastyValues, isSuperFlex)
 			enrichRowsWithDynastyValues(unrankedRows, dynastyValues, isSuperFlex)
 			enrichRowsWithDynastyValues(benchRows, dynastyValues, isSuperFlex)
 			enrichRowsWithDynastyValues(benchUnrankedRows, dynastyValues, isSuperFlex)
+			enrichRowsWithDynastyValues(taxiRows, dynastyValues, isSuperFlex)
+			enrichRowsWithDynastyValues(taxiUnrankedRows, dynastyValues, isSuperFlex)
 			debugLog("[DEBUG] Enriched starter and bench rows with dynasty values")
 		}
 
@@ -665,16 +667,10 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 
 		// --- FREE AGENTS LOGIC ---
 		// Find all rostered player IDs
-		rostered := map[string]bool{}
-		for _, r := range rosters {
-			for _, pid := range toStringSlice(r["players"]) {
-				rostered[pid] = true
-			}
-			for _, pid := range toStringSlice(r["reserve"]) {
-				rostered[pid] = true
-			}
-		}
+		rostered := buildRosteredSet(rosters)
 		debugLog("[DEBUG] Rostered player IDs: %v", rostered)
+		trendingAdds := fetchTrendingPlayers("add")
+		trendingDrops := fetchTrendingPlayers("drop")
 		// Find free agents: not rostered, not on user's team, valid tier
 		type faInfo struct {
 			pid         string
@@ -903,6 +899,7 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			rows := []PlayerRow{}
 			for _, fa := range posList {
 				rows = append(rows, PlayerRow{
+					PlayerID:      fa.pid,
 					Pos:           fa.pos,
 					Name:          fa.name,
 					Tier:          fa.tier,
@@ -911,6 +908,8 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 					UpgradeFor:    fa.upgradeFor,
 					UpgradeType:   fa.upgradeType,
 					RosterPercent: fa.percent,
+					TrendingAdds:  trendingAdds[fa.pid],
+					TrendingDrops: trendingDrops[fa.pid],
 				})
 			}
 			if len(rows) > 0 {
@@ -974,13 +973,19 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		var topFreeAgentsByValue []PlayerRow
 		var totalRosterValue int
 		if isDynasty && dynastyValues != nil {
-			// Calculate total roster value (starters + bench)
+			// Calculate total roster value (starters + bench + taxi)
 			for _, row := range startersRows {
 				totalRosterValue += row.DynastyValue
 			}
 			for _, row := range benchRows {
 				totalRosterValue += row.DynastyValue
 			}
+			for _, row := range taxiRows {
+				totalRosterValue += row.DynastyValue
+			}
+			for _, row := range taxiUnrankedRows {
+				totalRosterValue += row.DynastyValue
+			}
 			debugLog("[DEBUG] Total roster value: %d", totalRosterValue)
 
 			// Find lowest dynasty values on current roster (to identify upgrade targets)
@@ -1109,37 +1114,34 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 					ageCount++
 				}
 			}
+			for _, row := range append(append([]PlayerRow{}, taxiRows...), taxiUnrankedRows...) {
+				if row.Age > 0 {
+					totalAge += row.Age
+					ageCount++
+				}
+			}
 			if ageCount > 0 {
 				userAvgAge = float64(totalAge) / float64(ageCount)
 			}
 			debugLog("[DEBUG] User's average roster age: %.2f (%d players)", userAvgAge, ageCount)
 		}
 
-		// Get league users for team names (for dynasty mode - used by both team ages and draft picks)
+		// Get league users for team names (used by team ages, draft picks, and opponent scouting)
 		var userNames map[string]string
-		if isDynasty {
+		if isDynasty || hasMatchups {
 			leagueUsers, err := appProvider.FetchLeagueUsers(leagueID)
 			if err != nil {
 				debugLog("[DEBUG] Could not fetch league users: %v", err)
 			}
 
 			// Create a map of user_id -> display_name
-			userNames = make(map[string]string)
-			if leagueUsers != nil {
-				for _, u := range leagueUsers {
-					if uid, ok := u["user_id"].(string); ok {
-						displayName := ""
-						if dn, ok := u["display_name"].(string); ok && dn != "" {
-							displayName = dn
-						} else if un, ok := u["username"].(string); ok {
-							displayName = un
-						}
-						userNames[uid] = displayName
-					}
-				}
-			}
+			userNames = leagueUserNames(leagueUsers)
 		}
 
+		// League standings with tiebreakers (wins, ties, points-for, divisions)
+		standings := computeStandings(rosters, userNames, userID)
+		standingByRoster := standingsByRoster(standings)
+
 		// Calculate average age for all teams in the league (for dynasty mode)
 		var teamAges []TeamAgeData
 		if isDynasty {
@@ -1195,19 +1197,16 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 					avgAge = float64(totalAge) / float64(ageCount)
 				}
 
-				// Get standings rank (wins)
-				rank := 0
-				if settings, ok := r["settings"].(map[string]interface{}); ok {
-					if wins, ok := settings["wins"].(float64); ok {
-						rank = int(wins)
-					}
-				}
-
+				standing := standingByRoster[int(rosterID)]
 				teamAges = append(teamAges, TeamAgeData{
 					TeamName:    teamName,
 					OwnerName:   ownerName,
 					AvgAge:      avgAge,
-					Rank:        rank,
+					Rank:        standing.Rank,
+					Wins:        standing.Wins,
+					Losses:      standing.Losses,
+					Ties:        standing.Ties,
+					PointsFor:   standing.PointsFor,
 					RosterID:    int(rosterID),
 					IsUserTeam:  (ownerID == userID),
 					RosterValue: rosterValue,
@@ -1225,6 +1224,13 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		// Fetch draft picks for dynasty leagues
 		var draftPicks []DraftPick
 		var projectedDraftPicks []ProjectedDraftPick
+		var pickCapital map[int]float64
+		var pickOwnership map[string]int
+		var pickSlots map[int]int
+		var draftOrderMethod string
+		draftOrderChoice := parseDraftOrderMethod(r.FormValue("draft_order_" + leagueID))
+		var picks *pickValuer
+		userPickValue := 0
 		if isDynasty {
 			// Fetch traded picks from Sleeper API
 			tradedPicks, err := appProvider.FetchLeagueTradedPicks(leagueID)
@@ -1256,13 +1262,7 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 				debugLog("[DEBUG] ===================================")
 			}
 
-			// Get league settings to determine number of rounds
-			numRounds := 3 // Default to 3 rounds
-			if settings, ok := league["settings"].(map[string]interface{}); ok {
-				if rounds, ok := settings["draft_rounds"].(float64); ok && rounds > 0 {
-					numRounds = int(rounds)
-				}
-			}
+			numRounds := leagueDraftRounds(league)
 			debugLog("[DEBUG] League has %d draft rounds", numRounds)
 
 			// Create map of roster_id -> user info for owner names
@@ -1289,15 +1289,30 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			debugLog("[DEBUG] User roster ID: %d", userRosterID)
 
 			draftPicks = buildDraftPicks(tradedPicks, rosters, rosterOwners, numRounds, userRosterID, currentYear, debugLog)
+			pickOwnership = buildPickOwnership(tradedPicks, rosters, numRounds, userRosterID, currentYear, nil)
+			// Project the upcoming rookie draft under the league's draft order method
+			draftYear := upcomingDraftYear(league)
+			if draftYear == 0 {
+				draftYear = currentYear
+			}
+			draftOrder := projectDraftOrder(league, leagueID, draftOrderChoice, week, hasMatchups, rosters, standings, draftYear)
+			if draftOrder != nil {
+				pickSlots = draftOrder.likelySlots()
+				draftOrderMethod = draftOrderMethodLabels[draftOrder.Method] + " (" + draftOrder.Source + ")"
+			} else {
+				pickSlots = pickSlotsFromStandings(standings)
+			}
+			picks = newPickValuer(fetchDynastyPickValues(), isSuperFlex, len(rosters), draftYear)
+			picks.faabBudget = leagueFAABBudget(league) // Prices FAAB sent in trades
+			pickCapital = pickValueByRoster(pickOwnership, pickSlots, picks)
 
-			// Calculate projected draft order for 2026
 			if len(draftPicks) > 0 && len(teamAges) > 0 {
-				currentYear := time.Now().Year()
-				// Project for next year's draft (2026 in February 2026)
-				targetYear := currentYear
-				projectedDraftPicks = calculateProjectedDraftPicks(draftPicks, teamAges, targetYear)
-				debugLog("[DEBUG] Calculated %d projected draft picks for %d", len(projectedDraftPicks), targetYear)
+				projectedDraftPicks = calculateProjectedDraftPicks(draftPicks, teamAges, draftYear, draftOrder)
+				debugLog("[DEBUG] Calculated %d projected draft picks for %d", len(projectedDraftPicks), draftYear)
 			}
+			userPickValue = valueDraftPicks(draftPicks, projectedDraftPicks, picks)
+			totalRosterValue += userPickValue
+			debugLog("[DEBUG] User draft pick value: %d (total with picks: %d)", userPickValue, totalRosterValue)
 		}
 
 		// Aggregate player news for dynasty leagues
@@ -1311,7 +1326,7 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 
 			// Find breakout candidates from bench (only if we have dynasty values)
 			if dynastyValues != nil {
-				breakoutCandidates = findBreakoutCandidates(benchRows)
+				breakoutCandidates = findBreakoutCandidates(append(append([]PlayerRow{}, benchRows...), taxiRows...))
 				debugLog("[DEBUG] Found %d breakout candidates", len(breakoutCandidates))
 
 				// Find aging players from starters and bench
@@ -1320,28 +1335,20 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			}
 
 			// Fetch recent league transactions
-			recentTransactions = fetchRecentTransactions(leagueID, week, players, rosters, userNames, dynastyValues, isSuperFlex)
+			recentTransactions = fetchRecentTransactions(leagueID, week, players, rosters, userNames, dynastyValues, isSuperFlex, picks)
 			debugLog("[DEBUG] Found %d recent transactions", len(recentTransactions))
 
 			// Analyze trade retrospectives (Feature #5)
 			if dynastyValues != nil && len(recentTransactions) > 0 {
-				recentTransactions = analyzeTradeRetrospective(recentTransactions, dynastyValues, isSuperFlex)
+				recentTransactions = analyzeTradeRetrospective(recentTransactions, dynastyValues, isSuperFlex, picks)
 			}
 		}
 
-		// Get top rookies for dynasty leagues
+		// Rank the league's upcoming rookie class
 		var topRookies []RookieProspect
+		rookieDraftYear := upcomingDraftYear(league)
 		if isDynasty {
-			topRookies = getTopRookies()
-			// Filter out defensive players (they have 0 fantasy value)
-			fantasyRookies := []RookieProspect{}
-			for _, r := range topRookies {
-				if r.Value > 0 {
-					fantasyRookies = append(fantasyRookies, r)
-				}
-			}
-			topRookies = fantasyRookies
-			debugLog("[DEBUG] Loaded %d top rookie prospects", len(topRookies))
+			topRookies = getTopRookies(dynastyValues, players, rookieDraftYear, isSuperFlex)
 		}
 
 		// Calculate league trends for dynasty leagues
@@ -1351,9 +1358,12 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			debugLog("[DEBUG] Calculated league trends: %d active teams, %d hot waiver players", len(leagueTrends.MostActiveTeams), len(leagueTrends.HotWaiverPlayers))
 		}
 
+		hotPickups := buildHotPickups(trendingAdds, trendingDrops, leagueTrends.HotWaiverPlayers, players, rostered, borisTiers)
+
 		// Calculate trade targets for dynasty leagues
 		var tradeTargets []TradeTarget
 		var positionalBreakdown PositionalKTC
+		var buyLowTargets []BuyLowTarget
 		if isDynasty && dynastyValues != nil {
 			// Build map of all rosters with enriched player rows
 			allRosters := make(map[int][]PlayerRow)
@@ -1395,10 +1405,14 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			// Combine user's starters and bench for trade analysis
 			userFullRoster := append([]PlayerRow{}, startersRows...)
 			userFullRoster = append(userFullRoster, benchRows...)
+			userFullRoster = append(userFullRoster, taxiRows...)
 
 			// Calculate user's positional breakdown
 			positionalBreakdown = calculatePositionalKTC(userFullRoster)
 
+			// Other teams' players the age curves expect to appreciate
+			buyLowTargets = findBuyLowTargets(allRosters, teamNamesMap, int(userRosterID), 10)
+
 			// Find trade targets
 			tradeTargets = findTradeTargets(userFullRoster, allRosters, teamNamesMap, int(userRosterID))
 			debugLog("[DEBUG] Found %d trade targets", len(tradeTargets))
@@ -1424,6 +1438,8 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 							target.TeamName,
 							target.YourSurplus,
 							target.TheirSurplus,
+							rosterPickAssets(pickOwnership, int(userRosterID), pickSlots, picks),
+							rosterPickAssets(pickOwnership, targetRosterID, pickSlots, picks),
 							dynastyValues,
 							isSuperFlex,
 							premiumEnabled,
@@ -1435,6 +1451,102 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
+		// Rate every team in the league (dynasty value only counts in dynasty leagues)
+		var strengthValues map[string]DynastyValue
+		if isDynasty {
+			strengthValues = dynastyValues
+		}
+		teamStrengths := calculateTeamStrengths(rosters, players, borisTiers, strengthValues, isSuperFlex, leagueRosterPositions, userNames)
+		positionMatrix := calculatePositionMatrix(rosters, players, borisTiers, strengthValues, isSuperFlex, leagueRosterPositions, userNames, userID)
+
+		// Rate the user's remaining regular-season opponents
+		var scheduleOutlook []ScheduleWeek
+		if hasMatchups {
+			remainingWeeks := []int{}
+			for w := week; w < leaguePlayoffWeekStart(league); w++ {
+				remainingWeeks = append(remainingWeeks, w)
+			}
+			if len(remainingWeeks) > 0 {
+				userRosterID, _ := userRoster["roster_id"].(float64)
+				weeklyMatchups := fetchMatchupsForWeeks(leagueID, remainingWeeks)
+				scheduleOutlook = buildScheduleOutlook(weeklyMatchups, int(userRosterID), teamStrengths)
+				debugLog("[DEBUG] Schedule outlook: %d remaining weeks rated", len(scheduleOutlook))
+			}
+		}
+
+		// Simulate playoff odds from the remaining schedule
+		var playoffOdds []PlayoffOdds
+		if hasMatchups {
+			userRosterID, _ := userRoster["roster_id"].(float64)
+			playoffOdds = calculateLeaguePlayoffOdds(league, leagueID, week, standings, teamStrengths, int(userRosterID))
+		}
+
+		// Completed regular-season weeks feed head-to-head history, all-play records, and the lineup audit
+		var completedMatchups map[int][]map[string]interface{}
+		var allPlayRecords []AllPlayRecord
+		var lineupAudit LineupAudit
+		if hasMatchups && week > 1 {
+			completedWeeks := []int{}
+			for w := 1; w < week && w < leaguePlayoffWeekStart(league); w++ {
+				completedWeeks = append(completedWeeks, w)
+			}
+			completedMatchups = fetchMatchupsForWeeks(leagueID, completedWeeks)
+			allPlayRecords = calculateAllPlayRecords(completedMatchups, standings)
+			lineupAudit = calculateLineupAudit(completedMatchups, leagueRosterPositions, players, standings, rosters)
+			debugLog("[DEBUG] All-play records for %d teams over %d weeks", len(allPlayRecords), len(completedMatchups))
+		}
+
+		// Build opponent scouting report (in-season only)
+		var opponentReport OpponentReport
+		if oppMatchup != nil {
+			oppRosterID, _ := oppMatchup["roster_id"].(float64)
+			userRosterID, _ := userRoster["roster_id"].(float64)
+			var oppRoster map[string]interface{}
+			for _, r := range rosters {
+				if rid, ok := r["roster_id"].(float64); ok && rid == oppRosterID {
+					oppRoster = r
+					break
+				}
+			}
+			if oppRoster != nil {
+				opponentReport.TeamName = rosterTeamName(oppRoster, userNames)
+				oppIR := toStringSlice(oppRoster["reserve"])
+				oppBench := diff(diff(toStringSlice(oppRoster["players"]), oppStarters), toStringSlice(oppRoster["taxi"]))
+				oppStarterRows, oppStarterUnranked, _ := buildRowsWithPositions(oppStarters, players, borisTiers, true, leagueRosterPositions, oppIR, nil)
+				oppBenchRows, oppBenchUnranked, _ := buildRowsWithPositions(oppBench, players, borisTiers, false, nil, oppIR, nil)
+				opponentReport.Starters = append(oppStarterRows, oppStarterUnranked...)
+				opponentReport.Bench = append(oppBenchRows, oppBenchUnranked...)
+				opponentReport.Injuries = collectInjuries(opponentReport.Starters, opponentReport.Bench)
+
+				// Compare starters by actual player position (FLEX slots resolved to RB/WR/TE)
+				userByPos, _, _ := buildRowsWithPositions(starters, players, borisTiers, false, nil, nil, nil)
+				oppByPos, _, _ := buildRowsWithPositions(oppStarters, players, borisTiers, false, nil, nil, nil)
+				opponentReport.PositionEdges = comparePositionalTiers(userByPos, oppByPos)
+
+				// Redraft leagues don't fetch transactions elsewhere
+				leagueTxns := recentTransactions
+				if !isDynasty {
+					leagueTxns = fetchRecentTransactions(leagueID, week, players, rosters, userNames, nil, false, nil)
+				}
+				opponentReport.RecentMoves = filterTransactionsByTeam(leagueTxns, opponentReport.TeamName, 5)
+
+				if len(completedMatchups) > 0 {
+					opponentReport.HeadToHead = calculateHeadToHead(completedMatchups, int(userRosterID), int(oppRosterID))
+				}
+				debugLog("[DEBUG] Opponent report for %s: %d injuries, %d moves, H2H %d-%d-%d", opponentReport.TeamName, len(opponentReport.Injuries), len(opponentReport.RecentMoves), opponentReport.HeadToHead.Wins, opponentReport.HeadToHead.Losses, opponentReport.HeadToHead.Ties)
+			}
+		}
+
+		// Taxi squad promotion/demotion suggestions
+		var taxiSuggestions []TaxiSuggestion
+		if isDynasty {
+			taxiSettings := parseTaxiSettings(league)
+			// Starter rows carry FLX tiers in flex slots, so rebuild them on the same position-tier scale as taxi and bench rows
+			starterPositionRows, _, _ := buildRowsWithPositions(starters, players, borisTiers, false, nil, irPlayers, nil)
+			taxiSuggestions = buildTaxiSuggestions(taxiRows, starterPositionRows, benchRows, benchUnrankedRows, taxiSettings)
+			debugLog("[DEBUG] Taxi settings: %+v, %d suggestions", taxiSettings, len(taxiSuggestions))
+		}
+
 		avgTier := avg(starterTiers)
 		avgOppTier := avg(oppTiers)
 		winProb, emoji := winProbability(avgTier, avgOppTier)
@@ -1468,7 +1580,61 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			rosterSlots = strings.Join(parts, ", ")
 		}
 
+		// Bye weeks and roster capacity feed drop-candidate pairing for adds
+		if hasMatchups {
+			if byes := fetchNFLByeWeeks(season); byes != nil {
+				for _, rows := range [][]PlayerRow{startersRows, unrankedRows, benchRows, benchUnrankedRows} {
+					applyByeWeeks(rows, byes)
+				}
+			}
+		}
+		irRows := []PlayerRow{}
+		for _, rows := range [][]PlayerRow{benchRows, benchUnrankedRows} {
+			for _, row := range rows {
+				if row.IsIR {
+					irRows = append(irRows, row)
+				}
+			}
+		}
+		activePlayers := len(diff(diff(allPlayers, irPlayers), taxiPlayers))
+		rosterStatus := buildRosterStatus(league, leagueRosterPositions, activePlayers, irRows, week)
+
+		// Age-curve projection of the user's roster; picks hold their value until they become players
+		var futureRosterValue []int
+		if isDynasty && dynastyValues != nil {
+			userRows := append(append(append(append([]PlayerRow{}, startersRows...), benchRows...), taxiRows...), taxiUnrankedRows...)
+			for y := 1; y <= AGE_CURVE_YEARS; y++ {
+				futureRosterValue = append(futureRosterValue, projectedRosterValue(userRows, y)+userPickValue)
+			}
+		}
+
+		// Power rankings cover every league; value and pick capital only count in dynasty
+		powerRankings := calculatePowerRankings(powerRankingInputs{
+			IsDynasty:   isDynasty,
+			Standings:   standings,
+			Strengths:   teamStrengths,
+			TeamAges:    teamAges,
+			PickCapital: pickCapital,
+			Weekly:      completedMatchups,
+			Weights:     powerRankingWeightsFor(isDynasty),
+		})
+
+		// Contention windows pair the user with complementary trade partners
+		var tradePartners []PowerRanking
+		if isDynasty {
+			tradePartners = contentionTradePartners(powerRankings)
+			annotateTradeTargetWindows(tradeTargets, powerRankings)
+			windowByRoster := make(map[int]string, len(powerRankings))
+			for _, r := range powerRankings {
+				windowByRoster[r.RosterID] = r.Window
+			}
+			for i := range teamAges {
+				teamAges[i].Window = windowByRoster[teamAges[i].RosterID]
+			}
+		}
+
 		leagueData := LeagueData{
+			LeagueID:             leagueID,
 			LeagueName:           leagueName,
 			Season:               season,
 			Scoring:              scoring,
@@ -1484,22 +1650,41 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 			WinProb:              winProb + " " + emoji,
 			Bench:                benchRows,
 			BenchUnranked:        benchUnrankedRows,
+			RosterStatus:         rosterStatus,
+			HotPickups:           hotPickups,
+			Taxi:                 taxiRows,
+			TaxiUnranked:         taxiUnrankedRows,
+			TaxiSuggestions:      taxiSuggestions,
+			OpponentReport:       opponentReport,
+			TeamStrengths:        teamStrengths,
+			ScheduleOutlook:      scheduleOutlook,
+			PlayoffOdds:          playoffOdds,
+			AllPlayRecords:       allPlayRecords,
+			LineupAudit:          lineupAudit,
 			FreeAgentsByPos:      freeAgentsByPos,
 			TopFreeAgents:        topFreeAgents,
 			TopFreeAgentsByValue: topFreeAgentsByValue,
 			TotalRosterValue:     totalRosterValue,
+			DraftPickValue:       userPickValue,
 			UserAvgAge:           userAvgAge,
 			TeamAges:             teamAges,
-			PowerRankings:        calculatePowerRankings(teamAges),
+			PowerRankings:        powerRankings,
+			TradePartners:        tradePartners,
 			DraftPicks:           draftPicks,
 			ProjectedDraftPicks:  projectedDraftPicks,
+			DraftOrderMethod:     draftOrderMethod,
+			DraftOrder:           draftOrderChoice,
 			TradeTargets:         tradeTargets,
 			PositionalBreakdown:  positionalBreakdown,
+			PositionMatrix:       positionMatrix,
 			PlayerNewsFeed:       playerNewsFeed,
 			BreakoutCandidates:   breakoutCandidates,
 			AgingPlayers:         agingPlayers,
+			BuyLowTargets:        buyLowTargets,
+			ProjectedRosterValue: futureRosterValue,
 			RecentTransactions:   recentTransactions,
 			TopRookies:           topRookies,
+			RookieDraftYear:      rookieDraftYear,
 			LeagueTrends:         leagueTrends,
 		}
 
@@ -1525,12 +1710,24 @@ func lookupHandler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// Generate waiver recommendations (Feature #10)
+		leagueData.WaiverBudget = parseWaiverBudget(league, rosters, userRoster, week)
+		if leagueData.WaiverBudget.IsFAAB {
+			faabBids := fetchFAABHistory(league, leagueID, week, players, borisTiers)
+			leagueData.FAABMarket = buildFAABMarket(faabBids, leagueData.Season)
+		}
 		if len(freeAgentsByPos) > 0 {
 			waiverRecs := generateWaiverRecommendations(leagueData, freeAgentsByPos, 10, isPremium)
 			leagueData.WaiverRecommendations = waiverRecs
 			debugLog("[DEBUG] Generated %d waiver recommendations", len(waiverRecs))
 		}
 
+		// Archive this week's state so the waiver and action models can be backtested offline
+		if leagueData.HasMatchups && !testMode {
+			if err := archiveWeeklySnapshot(snapshotArchiveDir(), leagueData, freeAgentsByPos, week); err != nil {
+				log.Printf("[ERROR] Failed to archive week %d snapshot for league %s: %v", week, leagueID, err)
+			}
+		}
+
 		// Generate season plan (Feature #11)
 		if isDynasty {
 			seasonPlan := generateSeasonPlan(leagueData, isPremium)
@@ -1694,6 +1891,14 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 		return nil, fmt.Errorf("no leagues found")
 	}
 
+	// Current NFL week drives the playoff odds simulation
+	currentWeek := 0
+	if state, err := appProvider.FetchNFLState(); err == nil {
+		if w, ok := state["week"].(float64); ok {
+			currentWeek = int(w)
+		}
+	}
+
 	// 3. Get players data (for dynasty values and age)
 	players, err := fetchPlayers()
 	if err != nil {
@@ -1753,16 +1958,7 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 		leagueSize := len(rosters)
 
 		// Determine scoring type
-		scoring := "PPR"
-		if scoringSettings, ok := league["scoring_settings"].(map[string]interface{}); ok {
-			if rec, ok := scoringSettings["rec"].(float64); ok {
-				if rec == 0.5 {
-					scoring = "Half PPR"
-				} else if rec == 0.0 {
-					scoring = "Standard"
-				}
-			}
-		}
+		scoring := leagueScoringFormat(league)
 
 		// Check if superflex
 		isSuperFlex := false
@@ -1801,31 +1997,25 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 			continue
 		}
 
-		// Get user's record (wins/losses)
-		if settings, ok := userRoster["settings"].(map[string]interface{}); ok {
-			wins := 0
-			losses := 0
-			if w, ok := settings["wins"].(float64); ok {
-				wins = int(w)
-			}
-			if l, ok := settings["losses"].(float64); ok {
-				losses = int(l)
+		// Get user's record and place in the standings
+		standings := computeStandings(rosters, nil, userID)
+		for _, e := range standings {
+			if e.IsUserTeam && e.Wins+e.Losses+e.Ties > 0 {
+				summary.Record = standingLabel(e)
+				break
 			}
-			if wins > 0 || losses > 0 {
-				summary.Record = fmt.Sprintf("%d-%d", wins, losses)
+		}
 
-				// Simple playoff status (need >50% win rate and >6 wins)
-				totalGames := wins + losses
-				if totalGames > 0 {
-					winPct := float64(wins) / float64(totalGames)
-					if winPct >= 0.6 && wins >= 6 {
-						summary.PlayoffStatus = "Clinched ✓"
-					} else if winPct >= 0.45 {
-						summary.PlayoffStatus = "In Hunt"
-					} else {
-						summary.PlayoffStatus = "Eliminated"
-					}
-				}
+		// Simulated playoff odds for in-season leagues
+		if status, _ := league["status"].(string); status == "in_season" && currentWeek > 0 {
+			userRosterID, _ := userRoster["roster_id"].(float64)
+			odds := calculateLeaguePlayoffOdds(league, leagueID, currentWeek, standings, nil, int(userRosterID))
+			if userOdds, ok := userPlayoffOdds(odds); ok {
+				summary.HasPlayoffOdds = true
+				summary.PlayoffPct = userOdds.PlayoffPct
+				summary.ByePct = userOdds.ByePct
+				summary.ChampionshipPct = userOdds.ChampionshipPct
+				summary.PlayoffStatus = playoffStatusLabel(userOdds)
 			}
 		}
 
@@ -1864,9 +2054,10 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 				summary.AvgAge = totalAge / float64(playerCount)
 			}
 
-			// Calculate value rank
-			var allRosterValues []int
+			// Calculate every team's value; the user's rank and trend come from the league history
+			rosterValues := make(map[int]int)
 			for _, roster := range rosters {
+				rosterID, _ := roster["roster_id"].(float64)
 				pids, _ := roster["players"].([]interface{})
 				rosterValue := 0
 				for _, pid := range pids {
@@ -1883,20 +2074,26 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 						}
 					}
 				}
-				allRosterValues = append(allRosterValues, rosterValue)
+				rosterValues[int(rosterID)] = rosterValue
 			}
 
-			// Sort and find rank
-			sort.Sort(sort.Reverse(sort.IntSlice(allRosterValues)))
-			for i, val := range allRosterValues {
-				if val == totalValue {
-					summary.ValueRank = i + 1
-					break
+			userRosterID, _ := userRoster["roster_id"].(float64)
+			history := RosterValueHistory{LeagueID: leagueID}
+			if testMode {
+				upsertRosterValueDay(&history, time.Now(), rosterValues)
+			} else {
+				var err error
+				history, err = recordRosterValues(valueHistoryDir(), leagueID, time.Now(), rosterValues)
+				if err != nil {
+					log.Printf("[ERROR] Failed to record roster value history for league %s: %v", leagueID, err)
+					totalErrors.Inc()
 				}
 			}
-
-			// Calculate value trend
-			summary.ValueTrend = getValueTrend(username, leagueID, totalValue)
+			summary.ValueRank = rankRosterValues(rosterValues)[int(userRosterID)]
+			summary.ValueHistory = rosterValueSeries(history, int(userRosterID))
+			summary.ValueDeltas = valueDeltas(history, int(userRosterID), time.Now())
+			summary.ValueTrend = valueTrendLabel(summary.ValueHistory, summary.ValueDeltas)
+			summary.ValueChart = buildValueChart(summary.ValueHistory, 240, 48)
 
 			// Get draft picks summary
 			summary.DraftPicksSummary = getDraftPicksSummary(leagueID, userRoster)
@@ -1922,48 +2119,6 @@ func buildDashboardPage(username string) (*DashboardPage, error) {
 	}, nil
 }
 
-func getValueTrend(username, leagueID string, currentValue int) string {
-	cacheKey := fmt.Sprintf("%s:%s", username, leagueID)
-
-	rosterValueTrendCache.RLock()
-	cached, exists := rosterValueTrendCache.data[cacheKey]
-	rosterValueTrendCache.RUnlock()
-
-	// If no cached value or too old, cache current and return stable
-	if !exists || time.Since(cached.Timestamp) > rosterValueTrendCache.ttl {
-		rosterValueTrendCache.Lock()
-		rosterValueTrendCache.data[cacheKey] = CachedRosterValue{
-			RosterValue: currentValue,
-			Timestamp:   time.Now(),
-		}
-		rosterValueTrendCache.Unlock()
-		return "→ stable"
-	}
-
-	// Calculate trend
-	delta := currentValue - cached.RosterValue
-	if cached.RosterValue == 0 {
-		return "→ stable"
-	}
-
-	deltaPct := float64(delta) / float64(cached.RosterValue) * 100
-
-	// Update cache with current value
-	rosterValueTrendCache.Lock()
-	rosterValueTrendCache.data[cacheKey] = CachedRosterValue{
-		RosterValue: currentValue,
-		Timestamp:   time.Now(),
-	}
-	rosterValueTrendCache.Unlock()
-
-	if deltaPct >= 1.0 {
-		return fmt.Sprintf("↗ +%.0f%%", deltaPct)
-	} else if deltaPct <= -1.0 {
-		return fmt.Sprintf("↘ %.0f%%", deltaPct)
-	}
-	return "→ stable"
-}
-
 func getDraftPicksSummary(leagueID string, userRoster map[string]interface{}) string {
 	// Fetch traded picks from API
 	tradedPicks, err := appProvider.FetchLeagueTradedPicks(leagueID)
@@ -0,0 +1,207 @@
+// ABOUTME: Hindsight lineup audit from completed weekly matchups
+// ABOUTME: Compares each team's started lineup to its best possible lineup and ranks manager efficiency
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+const LINEUP_AUDIT_WORST_DECISIONS = 5
+
+// auditWeekLineup scores one team's week: points started, best possible points, and the
+// bench swaps that would have closed the gap. Stashed (taxi/IR) players can't be started,
+// so they only count toward the best lineup in weeks they actually started.
+func auditWeekLineup(m map[string]interface{}, week int, slots []string, players map[string]interface{}, stashed map[string]bool) (float64, float64, []LineupDecision) {
+	starters := toStringSlice(m["starters"])
+	points, _ := m["players_points"].(map[string]interface{})
+	playerPoints := func(pid string) float64 {
+		v, _ := points[pid].(float64)
+		return v
+	}
+	playerPos := func(pid string) (string, string) {
+		if p, ok := players[pid].(map[string]interface{}); ok {
+			pos, _ := p["position"].(string)
+			return getPlayerName(p), pos
+		}
+		return pid, ""
+	}
+
+	actual := 0.0
+	for _, pid := range starters {
+		actual += playerPoints(pid)
+	}
+	if actual == 0 {
+		actual = matchupPoints(m)
+	}
+
+	started := make(map[string]bool)
+	for _, pid := range starters {
+		started[pid] = true
+	}
+	candidates := []lineupCandidate{}
+	for _, pid := range toStringSlice(m["players"]) {
+		if stashed[pid] && !started[pid] {
+			continue
+		}
+		name, pos := playerPos(pid)
+		candidates = append(candidates, lineupCandidate{ID: pid, Name: name, Pos: pos, Score: playerPoints(pid)})
+	}
+	lineup, optimal := optimalLineup(slots, candidates)
+
+	inOptimal := make(map[string]bool)
+	for _, c := range lineup {
+		inOptimal[c.ID] = true
+	}
+
+	// Starters that didn't belong, keyed by the slot they occupied
+	type sitter struct {
+		pid  string
+		slot string
+	}
+	sitters := []sitter{}
+	for i, pid := range starters {
+		if pid == "" || pid == "0" || inOptimal[pid] || i >= len(slots) {
+			continue
+		}
+		sitters = append(sitters, sitter{pid: pid, slot: slots[i]})
+	}
+	benched := []lineupCandidate{}
+	for _, c := range lineup {
+		if !started[c.ID] {
+			benched = append(benched, c)
+		}
+	}
+	sort.SliceStable(benched, func(i, j int) bool {
+		return benched[i].Score > benched[j].Score
+	})
+
+	decisions := []LineupDecision{}
+	for _, b := range benched {
+		best := -1
+		for i, s := range sitters {
+			if !slotAccepts(s.slot, b.Pos) {
+				continue
+			}
+			if best < 0 || playerPoints(s.pid) < playerPoints(sitters[best].pid) {
+				best = i
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		s := sitters[best]
+		sitters = append(sitters[:best], sitters[best+1:]...)
+		lost := b.Score - playerPoints(s.pid)
+		if lost <= 0 {
+			continue
+		}
+		startedName, startedPos := playerPos(s.pid)
+		decisions = append(decisions, LineupDecision{
+			Week:          week,
+			Started:       startedName,
+			StartedPos:    startedPos,
+			StartedPoints: playerPoints(s.pid),
+			Benched:       b.Name,
+			BenchedPos:    b.Pos,
+			BenchedPoints: b.Score,
+			PointsLost:    math.Round(lost*10) / 10,
+		})
+	}
+	return actual, optimal, decisions
+}
+
+// calculateLineupAudit audits every team's lineups across completed weeks.
+// Weeks with no scored players are skipped.
+func calculateLineupAudit(weekly map[int][]map[string]interface{}, rosterPositions []string, players map[string]interface{}, standings []StandingsEntry, rosters []map[string]interface{}) LineupAudit {
+	slots := starterSlots(rosterPositions)
+	audit := LineupAudit{}
+	if len(slots) == 0 {
+		return audit
+	}
+
+	byRoster := make(map[int]*ManagerEfficiency)
+	for _, e := range standings {
+		byRoster[e.RosterID] = &ManagerEfficiency{RosterID: e.RosterID, TeamName: e.TeamName, IsUserTeam: e.IsUserTeam}
+	}
+	stashed := make(map[int]map[string]bool)
+	for _, r := range rosters {
+		rid, _ := r["roster_id"].(float64)
+		ids := make(map[string]bool)
+		for _, pid := range append(toStringSlice(r["taxi"]), toStringSlice(r["reserve"])...) {
+			ids[pid] = true
+		}
+		stashed[int(rid)] = ids
+	}
+
+	weeks := make([]int, 0, len(weekly))
+	for w := range weekly {
+		weeks = append(weeks, w)
+	}
+	sort.Ints(weeks)
+
+	userDecisions := []LineupDecision{}
+	for _, w := range weeks {
+		for _, m := range weekly[w] {
+			rid, ok := m["roster_id"].(float64)
+			if !ok {
+				continue
+			}
+			team, ok := byRoster[int(rid)]
+			if !ok {
+				continue
+			}
+			actual, optimal, decisions := auditWeekLineup(m, w, slots, players, stashed[int(rid)])
+			if optimal == 0 {
+				continue // Week not played yet
+			}
+			team.Weeks++
+			team.ActualPoints += actual
+			team.OptimalPoints += optimal
+			if team.IsUserTeam {
+				audit.UserWeeks = append(audit.UserWeeks, LineupWeek{
+					Week:       w,
+					Actual:     math.Round(actual*10) / 10,
+					Optimal:    math.Round(optimal*10) / 10,
+					PointsLeft: math.Round((optimal-actual)*10) / 10,
+				})
+				userDecisions = append(userDecisions, decisions...)
+			}
+		}
+	}
+
+	for _, team := range byRoster {
+		if team.Weeks == 0 {
+			continue
+		}
+		team.PointsLeft = math.Round((team.OptimalPoints-team.ActualPoints)*10) / 10
+		team.Efficiency = math.Round(team.ActualPoints/team.OptimalPoints*1000) / 10
+		team.ActualPoints = math.Round(team.ActualPoints*10) / 10
+		team.OptimalPoints = math.Round(team.OptimalPoints*10) / 10
+		audit.Managers = append(audit.Managers, *team)
+	}
+	sort.Slice(audit.Managers, func(i, j int) bool {
+		if audit.Managers[i].Efficiency != audit.Managers[j].Efficiency {
+			return audit.Managers[i].Efficiency > audit.Managers[j].Efficiency
+		}
+		return audit.Managers[i].RosterID < audit.Managers[j].RosterID
+	})
+	for i := range audit.Managers {
+		audit.Managers[i].Rank = i + 1
+		if audit.Managers[i].IsUserTeam {
+			audit.User = audit.Managers[i]
+		}
+	}
+
+	sort.SliceStable(userDecisions, func(i, j int) bool {
+		return userDecisions[i].PointsLost > userDecisions[j].PointsLost
+	})
+	if len(userDecisions) > LINEUP_AUDIT_WORST_DECISIONS {
+		userDecisions = userDecisions[:LINEUP_AUDIT_WORST_DECISIONS]
+	}
+	audit.WorstDecisions = userDecisions
+
+	debugLog("[DEBUG] Lineup audit covered %d teams over %d weeks", len(audit.Managers), len(weeks))
+	return audit
+}
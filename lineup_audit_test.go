@@ -0,0 +1,106 @@
+package main
+
+import "testing"
+
+func auditMatchup(rosterID int, starters, roster []string, points map[string]float64) map[string]interface{} {
+	pp := map[string]interface{}{}
+	total := 0.0
+	for pid, v := range points {
+		pp[pid] = v
+	}
+	for _, pid := range starters {
+		total += points[pid]
+	}
+	toIface := func(ids []string) []interface{} {
+		out := make([]interface{}, len(ids))
+		for i, id := range ids {
+			out[i] = id
+		}
+		return out
+	}
+	return map[string]interface{}{
+		"roster_id":      float64(rosterID),
+		"matchup_id":     float64(1),
+		"points":         total,
+		"starters":       toIface(starters),
+		"players":        toIface(roster),
+		"players_points": pp,
+	}
+}
+
+func TestCalculateLineupAudit(t *testing.T) {
+	players := map[string]interface{}{
+		"qb1": map[string]interface{}{"first_name": "Q", "last_name": "One", "position": "QB"},
+		"rb1": map[string]interface{}{"first_name": "R", "last_name": "One", "position": "RB"},
+		"rb2": map[string]interface{}{"first_name": "R", "last_name": "Two", "position": "RB"},
+		"wr1": map[string]interface{}{"first_name": "W", "last_name": "One", "position": "WR"},
+		"wr2": map[string]interface{}{"first_name": "W", "last_name": "Two", "position": "WR"},
+	}
+	positions := []string{"QB", "RB", "FLEX", "BN", "BN"}
+	standings := []StandingsEntry{
+		{RosterID: 1, TeamName: "Mine", IsUserTeam: true},
+		{RosterID: 2, TeamName: "Theirs"},
+	}
+	roster := []string{"qb1", "rb1", "rb2", "wr1", "wr2"}
+	weekly := map[int][]map[string]interface{}{
+		1: {
+			// User starts WR2 (4 pts) in the flex while WR1 scores 20 on the bench
+			auditMatchup(1, []string{"qb1", "rb1", "wr2"}, roster, map[string]float64{"qb1": 20, "rb1": 10, "rb2": 3, "wr1": 20, "wr2": 4}),
+			auditMatchup(2, []string{"qb1", "rb1", "wr1"}, roster, map[string]float64{"qb1": 15, "rb1": 12, "rb2": 2, "wr1": 9, "wr2": 1}),
+		},
+	}
+
+	audit := calculateLineupAudit(weekly, positions, players, standings, nil)
+	if len(audit.Managers) != 2 {
+		t.Fatalf("expected 2 managers, got %d", len(audit.Managers))
+	}
+	if audit.Managers[0].TeamName != "Theirs" || audit.Managers[0].Efficiency != 100 {
+		t.Fatalf("expected perfect manager first, got %+v", audit.Managers[0])
+	}
+	if audit.User.PointsLeft != 16 || audit.User.OptimalPoints != 50 || audit.User.Rank != 2 {
+		t.Fatalf("unexpected user audit: %+v", audit.User)
+	}
+	if len(audit.UserWeeks) != 1 || audit.UserWeeks[0].PointsLeft != 16 {
+		t.Fatalf("unexpected user weeks: %+v", audit.UserWeeks)
+	}
+	if len(audit.WorstDecisions) != 1 {
+		t.Fatalf("expected one bad decision, got %+v", audit.WorstDecisions)
+	}
+	d := audit.WorstDecisions[0]
+	if d.Started != "W Two" || d.Benched != "W One" || d.PointsLost != 16 {
+		t.Fatalf("unexpected decision: %+v", d)
+	}
+}
+
+func TestCalculateLineupAuditSkipsUnplayedWeeks(t *testing.T) {
+	standings := []StandingsEntry{{RosterID: 1, TeamName: "Mine", IsUserTeam: true}}
+	weekly := map[int][]map[string]interface{}{
+		3: {auditMatchup(1, []string{"qb1"}, []string{"qb1"}, map[string]float64{})},
+	}
+	players := map[string]interface{}{"qb1": map[string]interface{}{"position": "QB"}}
+	audit := calculateLineupAudit(weekly, []string{"QB"}, players, standings, nil)
+	if len(audit.Managers) != 0 || audit.User.Weeks != 0 {
+		t.Fatalf("expected empty audit for unplayed week, got %+v", audit)
+	}
+}
+
+func TestCalculateLineupAuditSkipsTaxiAndIR(t *testing.T) {
+	players := map[string]interface{}{
+		"qb1":  map[string]interface{}{"position": "QB"},
+		"qb2":  map[string]interface{}{"position": "QB"},
+		"taxi": map[string]interface{}{"position": "QB"},
+	}
+	standings := []StandingsEntry{{RosterID: 1, TeamName: "Mine", IsUserTeam: true}}
+	rosters := []map[string]interface{}{
+		{"roster_id": float64(1), "taxi": []interface{}{"taxi"}, "reserve": []interface{}{"qb2"}},
+	}
+	weekly := map[int][]map[string]interface{}{
+		1: {auditMatchup(1, []string{"qb1"}, []string{"qb1", "qb2", "taxi"}, map[string]float64{"qb1": 10, "qb2": 15, "taxi": 30})},
+		// A player stashed now but started that week still counts
+		2: {auditMatchup(1, []string{"qb2"}, []string{"qb1", "qb2", "taxi"}, map[string]float64{"qb1": 10, "qb2": 15, "taxi": 30})},
+	}
+	audit := calculateLineupAudit(weekly, []string{"QB"}, players, standings, rosters)
+	if audit.User.PointsLeft != 0 || audit.User.OptimalPoints != 25 {
+		t.Fatalf("expected taxi and IR stashes kept out of the best lineup, got %+v", audit.User)
+	}
+}
@@ -47,13 +47,40 @@ var dynastyValuesCache = &dynastyCache{
 	ttl:  24 * time.Hour, // Cache for 24 hours (values don't change frequently)
 }
 
+var dynastyPickValuesCache = &dynastyCache{
+	data:       make(map[string]DynastyValue),
+	ttl:        24 * time.Hour,   // Pick values update with the player values
+	failureTTL: 10 * time.Minute, // Back off after a failed download instead of retrying every lookup
+}
+
 var sleeperPlayersCache = &playersCache{
 	ttl: 1 * time.Hour, // Cache players data for 1 hour
 }
 
-var rosterValueTrendCache = &valueTrendCache{
-	data: make(map[string]CachedRosterValue),
-	ttl:  24 * time.Hour, // Compare values over 24 hours
+var sleeperTrendingCache = &trendingCache{
+	data:       make(map[string]map[string]int),
+	timestamp:  make(map[string]time.Time),
+	ttl:        15 * time.Minute, // Trending counts move quickly on waiver days
+	failureTTL: 5 * time.Minute,  // Back off after a failed fetch instead of retrying every lookup
+}
+
+var nflByeWeeksCache = &byeWeeksCache{
+	data:       make(map[string]map[string]int),
+	timestamp:  make(map[string]time.Time),
+	ttl:        24 * time.Hour,   // Schedule is fixed for the season
+	failureTTL: 10 * time.Minute, // Back off after a failed fetch instead of retrying every lookup
+}
+
+var leagueMatchupsCache = &matchupsCache{
+	data:      make(map[string][]map[string]interface{}),
+	timestamp: make(map[string]time.Time),
+	ttl:       10 * time.Minute, // Live weeks change during games; completed weeks are stable
+}
+
+var leagueTransactionsCache = &matchupsCache{
+	data:      make(map[string][]map[string]interface{}),
+	timestamp: make(map[string]time.Time),
+	ttl:       6 * time.Hour, // Completed waiver history rarely changes
 }
 
 // gzipResponseWriter wraps http.ResponseWriter to support gzip compression
@@ -190,7 +217,8 @@ var funcMap = template.FuncMap{
 		}
 		return t.Format("Jan 2, 2006")
 	},
-	"add": func(a, b int) int { return a + b },
+	"add":               func(a, b int) int { return a + b },
+	"draftOrderOptions": draftOrderOptions,
 }
 
 var templates = template.Must(template.New("").Funcs(funcMap).ParseGlob("templates/*.html"))
@@ -291,6 +319,10 @@ func main() {
 	http.Handle("/about", wrapHandler("about", aboutHandler))
 	http.Handle("/faq", wrapHandler("faq", faqHandler))
 	http.Handle("/demo", wrapHandler("demo", demoHandler))
+	http.Handle("/free-agents", wrapHandler("free_agents", freeAgentsHandler))
+	http.Handle("/draft", wrapHandler("draft_room", draftRoomHandler))
+	http.Handle("/mock-draft", wrapHandler("mock_draft", mockDraftHandler))
+	http.Handle("/trade-evaluator", wrapHandler("trade_evaluator", tradeEvaluatorHandler))
 	http.Handle("/status", wrapHandler("status", publicStatusHandler))
 	http.Handle("/robots.txt", wrapHandler("robots", robotsHandler))
 	http.Handle("/sitemap.xml", wrapHandler("sitemap", sitemapHandler))
@@ -0,0 +1,758 @@
+// ABOUTME: Offline mock draft simulator for dynasty rookie drafts and startup/redraft drafts
+// ABOUTME: Simulates other managers from the board and their needs with configurable randomness and reports availability at each user pick
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MOCK_DRAFT_SIMULATIONS     = 200  // Default simulations per request
+	MOCK_DRAFT_MAX_SIMULATIONS = 1000 // Upper bound for the sims parameter
+	MOCK_DRAFT_RANDOMNESS      = 0.35 // Default randomness (0 = everyone drafts the board, 1 = chaotic)
+	MOCK_DRAFT_LOOKAHEAD       = 12   // Available players a manager considers at each pick
+	MOCK_DRAFT_NEED_BONUS      = 3.5  // Board spots a manager will reach to fill a need
+	MOCK_DRAFT_NOISE           = 4.0  // Board spots of noise at full randomness
+	MOCK_DRAFT_POOL_EXTRA      = 30   // Players beyond the draft's pick count kept in the pool
+	MOCK_DRAFT_OUTLOOK_SIZE    = 8    // Players listed per user pick
+	MOCK_DRAFT_MIN_AVAILABLE   = 10.0 // Availability (%) below which a player isn't listed
+)
+
+// Strategies for the user's own picks; anything else is read as a position plan ("RB,WR,RB")
+const (
+	MockStrategyBPA  = "bpa"
+	MockStrategyNeed = "need"
+)
+
+// depthAllowance is how many players past the starting lineup a startup drafter takes at each position
+var depthAllowance = map[string]int{"QB": 1, "RB": 3, "WR": 3, "TE": 1}
+
+// MockDraftConfig controls one mock draft run
+type MockDraftConfig struct {
+	Strategy    string   // bpa, need, or a comma-separated position plan
+	Plan        []string // Positions for the user's picks in order when Strategy is a plan
+	Randomness  float64  // 0-1
+	Simulations int
+	Seed        int64
+}
+
+// MockDraftPick is one pick in the sample draft
+type MockDraftPick struct {
+	PickNo   int
+	Round    int
+	Slot     int // Pick within the round
+	TeamName string
+	Player   string
+	Pos      string
+	Value    int
+	IsUser   bool
+}
+
+// MockAvailablePlayer is a player who may still be on the board at a user pick
+type MockAvailablePlayer struct {
+	Name         string
+	Pos          string
+	Rank         int     // Board rank
+	AvailablePct float64 // Share of simulations he was there when the user picked
+	TakenPct     float64 // Share of simulations the user's strategy took him here
+}
+
+// MockPickOutlook is what the user can expect at one of their picks
+type MockPickOutlook struct {
+	PickNo  int
+	Round   int
+	Slot    int
+	Players []MockAvailablePlayer
+}
+
+// MockDraftPage is the mock draft view (also served as JSON with format=json)
+type MockDraftPage struct {
+	LeagueID     string
+	LeagueName   string
+	Username     string
+	Mode         string // "rookie" or "startup"
+	DraftOrder   string // Draft order method rookie picks are slotted by
+	IsDynasty    bool
+	DraftYear    int
+	OrderSource  string
+	BoardSource  string
+	Teams        int
+	Rounds       int
+	Strategy     string
+	Randomness   float64
+	Simulations  int
+	Seed         int64
+	UserNeeds    []string
+	Outlook      []MockPickOutlook
+	Sample       []MockDraftPick // First simulated draft
+	AvgHaulValue int             // Average total value of the user's picks across simulations
+}
+
+// mockDraftSlot is one pick in the draft order
+type mockDraftSlot struct {
+	PickNo   int
+	Round    int
+	Slot     int
+	RosterID int
+}
+
+// mockDraftTeam is one manager's state going into the draft
+type mockDraftTeam struct {
+	RosterID int
+	Name     string
+	IsUser   bool
+	Want     map[string]int // Open starting spots (or flagged needs) per position
+	Cap      map[string]int // Most players a team takes at a position; nil = no limit
+}
+
+// mockTeamState tracks a team's picks during one simulated draft
+type mockTeamState struct {
+	team      *mockDraftTeam
+	have      map[string]int
+	remaining int // Picks left, including the current one
+}
+
+// mockSnakeOrder builds the full order from the roster drafting in each slot (index 0 = slot 1)
+func mockSnakeOrder(rosterBySlot []int, rounds int, draftType string, reversalRound int) []mockDraftSlot {
+	teams := len(rosterBySlot)
+	order := make([]mockDraftSlot, 0, teams*rounds)
+	for pickNo := 1; pickNo <= teams*rounds; pickNo++ {
+		slot := draftSlotForPick(pickNo, teams, draftType, reversalRound)
+		order = append(order, mockDraftSlot{
+			PickNo:   pickNo,
+			Round:    (pickNo-1)/teams + 1,
+			Slot:     (pickNo-1)%teams + 1,
+			RosterID: rosterBySlot[slot-1],
+		})
+	}
+	return order
+}
+
+// mockRookieOrder builds the year's rookie draft from pick ownership. Each original owner drafts
+// from its slot (original roster ID -> slot; rosters without one follow in roster order), rounds run
+// linear or snake with an optional reversal round, and every pick goes to whoever owns it now.
+func mockRookieOrder(ownership map[string]int, slots map[int]int, year int, draftType string, reversalRound int) []mockDraftSlot {
+	owners := map[[2]int]int{} // Round and original roster -> current owner
+	seen := map[int]bool{}
+	bySlot := []int{}
+	rounds := 0
+	for _, p := range parseOwnership(ownership) {
+		if p.Year != year {
+			continue
+		}
+		if !seen[p.OriginalRosterID] {
+			seen[p.OriginalRosterID] = true
+			bySlot = append(bySlot, p.OriginalRosterID)
+		}
+		owners[[2]int{p.Round, p.OriginalRosterID}] = p.Owner
+		if p.Round > rounds {
+			rounds = p.Round
+		}
+	}
+	slotOf := func(rosterID int) int {
+		if s := slots[rosterID]; s > 0 {
+			return s
+		}
+		return 1000 + rosterID
+	}
+	sort.SliceStable(bySlot, func(i, j int) bool {
+		if slotOf(bySlot[i]) != slotOf(bySlot[j]) {
+			return slotOf(bySlot[i]) < slotOf(bySlot[j])
+		}
+		return bySlot[i] < bySlot[j]
+	})
+	if len(bySlot) == 0 {
+		return nil
+	}
+
+	order := []mockDraftSlot{}
+	for _, pick := range mockSnakeOrder(bySlot, rounds, draftType, reversalRound) {
+		owner, ok := owners[[2]int{pick.Round, pick.RosterID}]
+		if !ok {
+			continue
+		}
+		pick.RosterID = owner
+		pick.PickNo = len(order) + 1
+		order = append(order, pick)
+	}
+	return order
+}
+
+// draftRosterSlots maps each roster to its slot in a Sleeper draft, from slot_to_roster_id or,
+// before Sleeper fills that in, from the user-keyed draft_order
+func draftRosterSlots(draft map[string]interface{}, rosters []map[string]interface{}) map[int]int {
+	slots := map[int]int{}
+	for slot, rid := range newDraftPickOwnership(draft, nil).slotRosters {
+		slots[rid] = slot
+	}
+	if len(slots) > 0 {
+		return slots
+	}
+	order, _ := draft["draft_order"].(map[string]interface{})
+	for _, r := range rosters {
+		rid, _ := r["roster_id"].(float64)
+		owner, _ := r["owner_id"].(string)
+		if slot, ok := order[owner].(float64); ok && owner != "" {
+			slots[int(rid)] = int(slot)
+		}
+	}
+	return slots
+}
+
+// mockLineupTeam sets a startup drafter's targets from the league's lineup
+func mockLineupTeam(rosterPositions []string) (map[string]int, map[string]int) {
+	demand := positionDemand(rosterPositions)
+	want := map[string]int{}
+	caps := map[string]int{}
+	for _, pos := range fantasyPositions {
+		want[pos] = int(math.Ceil(demand[pos]))
+		caps[pos] = want[pos]
+		if want[pos] > 0 {
+			caps[pos] += depthAllowance[pos]
+		}
+	}
+	return want, caps
+}
+
+// mockEligible reports whether a team would consider a player at this point of its draft.
+// Kickers and defenses wait until a team's last picks.
+func mockEligible(st *mockTeamState, pos string) bool {
+	if st.team.Cap != nil && st.have[pos] >= st.team.Cap[pos] {
+		return false
+	}
+	if pos == "K" || pos == "DEF" {
+		late := 0
+		for _, p := range []string{"K", "DEF"} {
+			if open := st.team.Want[p] - st.have[p]; open > 0 {
+				late += open
+			}
+		}
+		return st.remaining <= late+1
+	}
+	return true
+}
+
+// mockChoose picks a player index from the available board for a simulated manager. Each of the
+// first few eligible players scores minus its board position, plus a need bonus and noise.
+func mockChoose(pool []PlayerRow, taken []bool, st *mockTeamState, randomness float64, rng *rand.Rand) int {
+	best, bestScore := -1, math.Inf(-1)
+	seen := 0
+	for i := range pool {
+		if taken[i] || !mockEligible(st, pool[i].Pos) {
+			continue
+		}
+		score := -float64(seen)
+		if st.team.Want[pool[i].Pos] > st.have[pool[i].Pos] {
+			score += MOCK_DRAFT_NEED_BONUS
+		}
+		if randomness > 0 {
+			score += rng.NormFloat64() * randomness * MOCK_DRAFT_NOISE
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+		seen++
+		if seen >= MOCK_DRAFT_LOOKAHEAD {
+			break
+		}
+	}
+	if best >= 0 {
+		return best
+	}
+	return firstAvailable(pool, taken)
+}
+
+// mockUserChoose applies the user's strategy for their nth pick (0-based)
+func mockUserChoose(pool []PlayerRow, taken []bool, st *mockTeamState, cfg MockDraftConfig, n int) int {
+	switch {
+	case cfg.Strategy == MockStrategyNeed:
+		seen := 0
+		for i := range pool {
+			if taken[i] || !mockEligible(st, pool[i].Pos) {
+				continue
+			}
+			if st.team.Want[pool[i].Pos] > st.have[pool[i].Pos] {
+				return i
+			}
+			seen++
+			if seen >= MOCK_DRAFT_LOOKAHEAD {
+				break
+			}
+		}
+	case len(cfg.Plan) > n:
+		for i := range pool {
+			if !taken[i] && pool[i].Pos == cfg.Plan[n] {
+				return i
+			}
+		}
+	}
+	for i := range pool {
+		if !taken[i] && mockEligible(st, pool[i].Pos) {
+			return i
+		}
+	}
+	return firstAvailable(pool, taken)
+}
+
+// firstAvailable returns the best player left on the board (-1 once it's empty)
+func firstAvailable(pool []PlayerRow, taken []bool) int {
+	for i := range pool {
+		if !taken[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// simulateMockDraft runs one draft. For each user pick it calls atUserPick with the players still
+// available just before the user chooses. It returns the chosen pool index per pick (-1 once the pool runs dry).
+func simulateMockDraft(order []mockDraftSlot, pool []PlayerRow, teams map[int]*mockDraftTeam, cfg MockDraftConfig, rng *rand.Rand, atUserPick func(userPick int, taken []bool)) []int {
+	states := make(map[int]*mockTeamState, len(teams))
+	for rid, t := range teams {
+		states[rid] = &mockTeamState{team: t, have: map[string]int{}}
+	}
+	for _, s := range order {
+		if st, ok := states[s.RosterID]; ok {
+			st.remaining++
+		}
+	}
+
+	taken := make([]bool, len(pool))
+	chosen := make([]int, len(order))
+	userPick := 0
+	for i, s := range order {
+		st, ok := states[s.RosterID]
+		if !ok {
+			st = &mockTeamState{team: &mockDraftTeam{RosterID: s.RosterID}, have: map[string]int{}, remaining: 1}
+			states[s.RosterID] = st
+		}
+		var pick int
+		if st.team.IsUser {
+			if atUserPick != nil {
+				atUserPick(userPick, taken)
+			}
+			pick = mockUserChoose(pool, taken, st, cfg, userPick)
+			userPick++
+		} else {
+			pick = mockChoose(pool, taken, st, cfg.Randomness, rng)
+		}
+		chosen[i] = pick
+		st.remaining--
+		if pick >= 0 {
+			taken[pick] = true
+			st.have[pool[pick].Pos]++
+		}
+	}
+	return chosen
+}
+
+// runMockDrafts simulates the draft cfg.Simulations times and summarizes the user's outlook
+func runMockDrafts(order []mockDraftSlot, pool []PlayerRow, teams map[int]*mockDraftTeam, cfg MockDraftConfig) ([]MockPickOutlook, []MockDraftPick, int) {
+	var userSlots []mockDraftSlot
+	for _, s := range order {
+		if t := teams[s.RosterID]; t != nil && t.IsUser {
+			userSlots = append(userSlots, s)
+		}
+	}
+	available := make([][]int, len(userSlots))
+	takenBy := make([][]int, len(userSlots))
+	for i := range userSlots {
+		available[i] = make([]int, len(pool))
+		takenBy[i] = make([]int, len(pool))
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	var sample []MockDraftPick
+	haulTotal := 0
+	for sim := 0; sim < cfg.Simulations; sim++ {
+		chosen := simulateMockDraft(order, pool, teams, cfg, rng, func(n int, taken []bool) {
+			for i := range pool {
+				if !taken[i] {
+					available[n][i]++
+				}
+			}
+		})
+		n := 0
+		for i, s := range order {
+			t := teams[s.RosterID]
+			isUser := t != nil && t.IsUser
+			if isUser {
+				if chosen[i] >= 0 {
+					takenBy[n][chosen[i]]++
+					haulTotal += pool[chosen[i]].DynastyValue
+				}
+				n++
+			}
+			if sim == 0 && chosen[i] >= 0 {
+				p := pool[chosen[i]]
+				pick := MockDraftPick{PickNo: s.PickNo, Round: s.Round, Slot: s.Slot, Player: p.Name, Pos: p.Pos, Value: p.DynastyValue, IsUser: isUser}
+				if t != nil {
+					pick.TeamName = t.Name
+				}
+				sample = append(sample, pick)
+			}
+		}
+	}
+
+	outlook := make([]MockPickOutlook, len(userSlots))
+	sims := float64(max(cfg.Simulations, 1))
+	for n, s := range userSlots {
+		outlook[n] = MockPickOutlook{PickNo: s.PickNo, Round: s.Round, Slot: s.Slot}
+		for i, p := range pool {
+			pct := math.Round(float64(available[n][i])/sims*1000) / 10
+			if pct < MOCK_DRAFT_MIN_AVAILABLE {
+				continue
+			}
+			outlook[n].Players = append(outlook[n].Players, MockAvailablePlayer{
+				Name:         p.Name,
+				Pos:          p.Pos,
+				Rank:         i + 1,
+				AvailablePct: pct,
+				TakenPct:     math.Round(float64(takenBy[n][i])/sims*1000) / 10,
+			})
+			if len(outlook[n].Players) >= MOCK_DRAFT_OUTLOOK_SIZE {
+				break
+			}
+		}
+	}
+	return outlook, sample, int(math.Round(float64(haulTotal) / sims))
+}
+
+// rankMockPool orders the pool by dynasty value or tier, drops unranked players, and trims it to size
+func rankMockPool(pool []PlayerRow, byValue bool, size int) []PlayerRow {
+	ranked := []PlayerRow{}
+	for _, row := range pool {
+		if byValue && row.DynastyValue <= 0 {
+			continue
+		}
+		if !byValue && parseTierFloat(row.Tier) <= 0 {
+			continue
+		}
+		ranked = append(ranked, row)
+	}
+	if byValue {
+		sortFreeAgents(ranked, "value")
+	} else {
+		sortFreeAgents(ranked, "tier")
+	}
+	if size > 0 && len(ranked) > size {
+		ranked = ranked[:size]
+	}
+	return ranked
+}
+
+// parseMockDraftConfig reads strategy, randomness, sims, and seed from the query string.
+// Without a seed the run is keyed to the league and user so reloads repeat.
+func parseMockDraftConfig(q url.Values, leagueID, username string) MockDraftConfig {
+	cfg := MockDraftConfig{
+		Strategy:    MockStrategyBPA,
+		Randomness:  MOCK_DRAFT_RANDOMNESS,
+		Simulations: MOCK_DRAFT_SIMULATIONS,
+	}
+	switch s := strings.ToLower(strings.TrimSpace(q.Get("strategy"))); s {
+	case "", MockStrategyBPA:
+	case MockStrategyNeed:
+		cfg.Strategy = s
+	default:
+		for _, pos := range strings.Split(strings.ToUpper(s), ",") {
+			pos = strings.TrimSpace(pos)
+			if pos == "DST" {
+				pos = "DEF"
+			}
+			if isFantasyPosition(pos) {
+				cfg.Plan = append(cfg.Plan, pos)
+			}
+		}
+		if len(cfg.Plan) > 0 {
+			cfg.Strategy = strings.Join(cfg.Plan, ",")
+		}
+	}
+	if v, err := strconv.ParseFloat(q.Get("randomness"), 64); err == nil {
+		cfg.Randomness = math.Max(0, math.Min(1, v))
+	}
+	if v, err := strconv.Atoi(q.Get("sims")); err == nil {
+		cfg.Simulations = max(1, min(v, MOCK_DRAFT_MAX_SIMULATIONS))
+	}
+	if v, err := strconv.ParseInt(q.Get("seed"), 10, 64); err == nil {
+		cfg.Seed = v
+	} else {
+		h := fnv.New64a()
+		h.Write([]byte(leagueID + ":" + strings.ToLower(username)))
+		cfg.Seed = int64(h.Sum64() >> 1)
+	}
+	return cfg
+}
+
+func mockDraftHandler(w http.ResponseWriter, r *http.Request) {
+	trackPageView(r.URL.Path)
+	q := r.URL.Query()
+	leagueID := strings.TrimSpace(q.Get("league_id"))
+	if leagueID == "" {
+		http.Error(w, "league_id is required", http.StatusBadRequest)
+		return
+	}
+	username := strings.TrimSpace(q.Get("user"))
+	if username == "" {
+		if cookie, err := r.Cookie("sleeper_username"); err == nil {
+			username = cookie.Value
+		}
+	}
+
+	cfg := parseMockDraftConfig(q, leagueID, username)
+	page, err := buildMockDraftPage(leagueID, username, strings.TrimSpace(q.Get("mode")), parseDraftOrderMethod(q.Get("draft_order")), cfg)
+	if err != nil {
+		log.Printf("[ERROR] Mock draft failed for league %s: %v", leagueID, err)
+		totalErrors.Inc()
+		http.Error(w, "failed to load league for mock draft", http.StatusBadGateway)
+		return
+	}
+
+	if q.Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+		return
+	}
+	if err := templates.ExecuteTemplate(w, "mock_draft.html", page); err != nil {
+		log.Printf("[ERROR] Mock draft template error: %v", err)
+		http.Error(w, "Error rendering mock draft", http.StatusInternalServerError)
+	}
+}
+
+// buildMockDraftPage fetches the league and runs the mock draft
+func buildMockDraftPage(leagueID, username, mode, draftOrder string, cfg MockDraftConfig) (*MockDraftPage, error) {
+	league, err := appProvider.FetchLeague(leagueID)
+	if err != nil || league == nil || league["league_id"] == nil {
+		return nil, fmt.Errorf("league %s not found: %v", leagueID, err)
+	}
+	rosters, err := appProvider.FetchLeagueRosters(leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("rosters: %w", err)
+	}
+	users, _ := appProvider.FetchLeagueUsers(leagueID)
+	players, err := fetchPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("players: %w", err)
+	}
+	userID := ""
+	if username != "" {
+		if user, err := appProvider.FetchUser(username); err == nil && user != nil {
+			userID, _ = user["user_id"].(string)
+		}
+	}
+
+	in := mockDraftInputs{
+		League:          league,
+		Rosters:         rosters,
+		UserNames:       leagueUserNames(users),
+		UserID:          userID,
+		Players:         players,
+		Tiers:           fetchBorisTiers(leagueScoringFormat(league)),
+		IsSuperFlex:     leagueIsSuperFlex(league),
+		RosterPositions: toStringSlice(league["roster_positions"]),
+		Mode:            mode,
+	}
+	if isDynastyLeague(league) {
+		in.DynastyValues, _ = fetchDynastyValues()
+		in.TradedPicks, _ = appProvider.FetchLeagueTradedPicks(leagueID)
+		in.DraftOrder = upcomingDraftOrder(league, leagueID, draftOrder, rosters, computeStandings(rosters, in.UserNames, userID))
+	}
+	if drafts, err := appProvider.FetchLeagueDrafts(leagueID); err == nil {
+		in.Draft = selectActiveDraft(drafts)
+	}
+
+	page := buildMockDraft(in, cfg)
+	page.Username = username
+	page.DraftOrder = draftOrder
+	return page, nil
+}
+
+// mockDraftInputs is everything the simulator needs, already fetched
+type mockDraftInputs struct {
+	League          map[string]interface{}
+	Draft           map[string]interface{} // Sleeper draft, if the league has one
+	Rosters         []map[string]interface{}
+	TradedPicks     []map[string]interface{}
+	UserNames       map[string]string
+	UserID          string
+	Players         map[string]interface{}
+	Tiers           map[string][][]string
+	DynastyValues   map[string]DynastyValue
+	IsSuperFlex     bool
+	RosterPositions []string
+	Mode            string                // "rookie" or "startup"; empty picks rookie for dynasty leagues
+	DraftOrder      *draftOrderProjection // Projected rookie draft order, if any
+}
+
+// buildMockDraft sets up the order, pool, and teams and runs the simulations. Dynasty leagues
+// rehearse their next rookie draft from pick ownership; everything else runs a full startup draft.
+func buildMockDraft(in mockDraftInputs, cfg MockDraftConfig) *MockDraftPage {
+	page := &MockDraftPage{
+		IsDynasty:   isDynastyLeague(in.League),
+		Strategy:    cfg.Strategy,
+		Randomness:  cfg.Randomness,
+		Simulations: cfg.Simulations,
+		Seed:        cfg.Seed,
+		Mode:        "startup",
+	}
+	page.LeagueID, _ = in.League["league_id"].(string)
+	page.LeagueName, _ = in.League["name"].(string)
+	byValue := page.IsDynasty && len(in.DynastyValues) > 0
+	if byValue && in.Mode != "startup" {
+		page.Mode = "rookie"
+	}
+
+	teams := map[int]*mockDraftTeam{}
+	rosterIDs := []int{}
+	userRosterID := 0
+	for _, r := range in.Rosters {
+		rid, _ := r["roster_id"].(float64)
+		owner, _ := r["owner_id"].(string)
+		t := &mockDraftTeam{RosterID: int(rid), Name: rosterTeamName(r, in.UserNames), IsUser: in.UserID != "" && owner == in.UserID}
+		if t.IsUser {
+			userRosterID = t.RosterID
+		}
+		teams[t.RosterID] = t
+		rosterIDs = append(rosterIDs, t.RosterID)
+	}
+	sort.Ints(rosterIDs)
+	page.Teams = len(rosterIDs)
+
+	var order []mockDraftSlot
+	var pool []PlayerRow
+	if page.Mode == "rookie" {
+		page.DraftYear = upcomingDraftYear(in.League)
+		if page.DraftYear == 0 {
+			page.DraftYear = time.Now().Year()
+		}
+		rounds := leagueDraftRounds(in.League)
+		slots := in.DraftOrder.likelySlots()
+		if len(slots) == page.Teams {
+			page.OrderSource = "pick ownership, slotted by " + draftOrderMethodLabels[in.DraftOrder.Method] + " (" + in.DraftOrder.Source + ")"
+		} else {
+			slots = pickSlotsFromStandings(computeStandings(in.Rosters, in.UserNames, in.UserID))
+			page.OrderSource = "pick ownership, slotted by standings"
+		}
+		draftType, reversal := "linear", 0
+
+		// The league's Sleeper draft for the year sets the format and, once it's set, the order
+		if season, _ := in.Draft["season"].(string); in.Draft != nil && season == strconv.Itoa(page.DraftYear) {
+			if t, _ := in.Draft["type"].(string); t == "snake" || t == "linear" {
+				draftType = t
+			}
+			reversal = draftSetting(in.Draft, "reversal_round")
+			if r := draftSetting(in.Draft, "rounds"); r > 0 {
+				rounds = r
+			}
+			if set := draftRosterSlots(in.Draft, in.Rosters); len(set) == page.Teams {
+				slots = set
+				page.OrderSource = "Sleeper draft order and pick ownership"
+			}
+		}
+		ownership := buildPickOwnership(in.TradedPicks, in.Rosters, rounds, userRosterID, page.DraftYear, nil)
+		order = mockRookieOrder(ownership, slots, page.DraftYear, draftType, reversal)
+		page.Rounds = rounds
+
+		rookies := rookiesFromDynastyValues(in.DynastyValues, page.DraftYear, in.IsSuperFlex)
+		if len(rookies) < minDynastyRookieClass {
+			rookies = getTopRookies(in.DynastyValues, in.Players, page.DraftYear, in.IsSuperFlex)
+		} else {
+			linkRookiesToSleeper(rookies, in.Players)
+		}
+		rostered := buildRosteredSet(in.Rosters)
+		for _, rk := range rookies {
+			if rk.PlayerID != "" && rostered[rk.PlayerID] {
+				continue
+			}
+			pool = append(pool, PlayerRow{PlayerID: rk.PlayerID, Name: rk.Name, Pos: rk.Position, RealPos: rk.Position, Team: rk.Team, DynastyValue: rk.Value})
+		}
+		page.BoardSource = "dynasty values"
+
+		// Managers lean toward the positions the league matrix flags as needs
+		matrix := calculatePositionMatrix(in.Rosters, in.Players, in.Tiers, in.DynastyValues, in.IsSuperFlex, in.RosterPositions, in.UserNames, in.UserID)
+		for _, row := range matrix.Teams {
+			if t := teams[row.RosterID]; t != nil {
+				t.Want = map[string]int{}
+				for _, pos := range row.Needs {
+					t.Want[pos] = 1
+				}
+			}
+		}
+	} else {
+		order, page.Rounds, page.OrderSource = mockStartupOrder(in.Draft, rosterIDs, len(in.RosterPositions))
+		pool = buildFreeAgentPool(in.Players, map[string]bool{}, in.Tiers, in.DynastyValues, in.IsSuperFlex, nil, nil, nil)
+		if byValue {
+			page.BoardSource = "dynasty values"
+		} else {
+			page.BoardSource = "tiers"
+		}
+		for _, t := range teams {
+			t.Want, t.Cap = mockLineupTeam(in.RosterPositions)
+		}
+	}
+	pool = rankMockPool(pool, byValue, len(order)+MOCK_DRAFT_POOL_EXTRA)
+	if t := teams[userRosterID]; t != nil {
+		for _, pos := range fantasyPositions {
+			if t.Want[pos] > 0 {
+				page.UserNeeds = append(page.UserNeeds, pos)
+			}
+		}
+	}
+	if len(order) == 0 || len(pool) == 0 {
+		return page
+	}
+	page.Outlook, page.Sample, page.AvgHaulValue = runMockDrafts(order, pool, teams, cfg)
+	return page
+}
+
+// mockStartupOrder uses the league's Sleeper draft order when one is set, else a snake in roster order
+func mockStartupOrder(draft map[string]interface{}, rosterIDs []int, lineupSize int) ([]mockDraftSlot, int, string) {
+	rounds := lineupSize
+	if rounds == 0 {
+		rounds = 15
+	}
+	bySlot := append([]int(nil), rosterIDs...)
+	draftType, reversal := "snake", 0
+	source := "snake in roster order"
+	if draft != nil {
+		if slots, ok := draft["slot_to_roster_id"].(map[string]interface{}); ok && len(slots) == len(rosterIDs) {
+			fromDraft := make([]int, len(slots))
+			complete := true
+			for slot, rid := range slots {
+				s, err := strconv.Atoi(slot)
+				v, ok := rid.(float64)
+				if err != nil || !ok || s < 1 || s > len(fromDraft) {
+					complete = false
+					break
+				}
+				fromDraft[s-1] = int(v)
+			}
+			if complete {
+				bySlot = fromDraft
+				source = "Sleeper draft order"
+				if r := draftSetting(draft, "rounds"); r > 0 {
+					rounds = r
+				}
+				if t, _ := draft["type"].(string); t != "" && t != "auction" {
+					draftType = t
+				}
+				reversal = draftSetting(draft, "reversal_round")
+			}
+		}
+	}
+	if len(bySlot) == 0 {
+		return nil, rounds, source
+	}
+	return mockSnakeOrder(bySlot, rounds, draftType, reversal), rounds, source
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func mockTestPool() []PlayerRow {
+	return []PlayerRow{
+		{Name: "One", Pos: "RB", DynastyValue: 9000},
+		{Name: "Two", Pos: "WR", DynastyValue: 8000},
+		{Name: "Three", Pos: "WR", DynastyValue: 7000},
+		{Name: "Four", Pos: "TE", DynastyValue: 6000},
+		{Name: "Five", Pos: "QB", DynastyValue: 5000},
+		{Name: "Six", Pos: "RB", DynastyValue: 4000},
+	}
+}
+
+func mockTestTeams() map[int]*mockDraftTeam {
+	return map[int]*mockDraftTeam{
+		1: {RosterID: 1, Name: "First"},
+		2: {RosterID: 2, Name: "Second"},
+		3: {RosterID: 3, Name: "Me", IsUser: true},
+	}
+}
+
+func mockPickedNames(pool []PlayerRow, chosen []int) []string {
+	names := []string{}
+	for _, c := range chosen {
+		if c >= 0 {
+			names = append(names, pool[c].Name)
+		}
+	}
+	return names
+}
+
+func TestMockRookieOrder(t *testing.T) {
+	ownership := map[string]int{
+		"2026-1-1": 1, "2026-1-2": 2, "2026-1-3": 1,
+		"2026-2-1": 1, "2026-2-2": 3, "2026-2-3": 3,
+		"2027-1-1": 2,
+	}
+	slots := map[int]int{2: 1, 3: 2, 1: 3} // Roster 2 finished worst
+	order := mockRookieOrder(ownership, slots, 2026, "linear", 0)
+	owners := []int{}
+	for _, s := range order {
+		owners = append(owners, s.RosterID)
+	}
+	if fmt.Sprint(owners) != "[2 1 1 3 3 1]" {
+		t.Fatalf("unexpected rookie order owners: %v", owners)
+	}
+	if order[4].PickNo != 5 || order[4].Round != 2 || order[4].Slot != 2 {
+		t.Fatalf("unexpected pick numbering: %+v", order[4])
+	}
+	// A snake runs round 2 back from the last slot
+	owners = owners[:0]
+	for _, s := range mockRookieOrder(ownership, slots, 2026, "snake", 0) {
+		owners = append(owners, s.RosterID)
+	}
+	if fmt.Sprint(owners) != "[2 1 1 1 3 3]" {
+		t.Fatalf("unexpected snake rookie order owners: %v", owners)
+	}
+
+	snake := mockSnakeOrder([]int{3, 1, 2}, 2, "snake", 0)
+	owners = owners[:0]
+	for _, s := range snake {
+		owners = append(owners, s.RosterID)
+	}
+	if fmt.Sprint(owners) != "[3 1 2 2 1 3]" || snake[3].Slot != 1 {
+		t.Fatalf("unexpected snake order: %v %+v", owners, snake[3])
+	}
+}
+
+func TestSimulateMockDraftWithoutRandomness(t *testing.T) {
+	pool := mockTestPool()
+	order := mockSnakeOrder([]int{1, 2, 3}, 2, "snake", 0)
+	cfg := MockDraftConfig{Strategy: MockStrategyBPA}
+
+	chosen := simulateMockDraft(order, pool, mockTestTeams(), cfg, nil, nil)
+	if got := fmt.Sprint(mockPickedNames(pool, chosen)); got != "[One Two Three Four Five Six]" {
+		t.Fatalf("expected everyone to draft the board, got %s", got)
+	}
+
+	// A need pulls a manager up to a few spots past the board
+	teams := mockTestTeams()
+	teams[1].Want = map[string]int{"TE": 1}
+	chosen = simulateMockDraft(order, pool, teams, cfg, nil, nil)
+	if pool[chosen[0]].Name != "Four" {
+		t.Fatalf("expected the TE-needy team to reach for the TE, got %s", pool[chosen[0]].Name)
+	}
+
+	// A full position is skipped
+	teams = mockTestTeams()
+	teams[2].Cap = map[string]int{"QB": 1, "RB": 1, "WR": 0, "TE": 1}
+	chosen = simulateMockDraft(order, pool, teams, cfg, nil, nil)
+	if pool[chosen[1]].Name != "Four" {
+		t.Fatalf("expected the capped team to skip WRs, got %s", pool[chosen[1]].Name)
+	}
+}
+
+func TestMockUserStrategies(t *testing.T) {
+	pool := mockTestPool()
+	order := mockSnakeOrder([]int{3, 1, 2}, 2, "snake", 0)
+
+	plan := parseMockDraftConfig(url.Values{"strategy": {"qb, te"}}, "L1", "me")
+	if plan.Strategy != "QB,TE" || len(plan.Plan) != 2 {
+		t.Fatalf("expected a position plan, got %+v", plan)
+	}
+	plan.Randomness = 0
+	chosen := simulateMockDraft(order, pool, mockTestTeams(), plan, nil, nil)
+	if pool[chosen[0]].Name != "Five" || pool[chosen[5]].Name != "Six" {
+		t.Fatalf("expected the plan to take the QB, then best available once the TE is gone, got %v", mockPickedNames(pool, chosen))
+	}
+
+	teams := mockTestTeams()
+	teams[3].Want = map[string]int{"WR": 1}
+	chosen = simulateMockDraft(order, pool, teams, MockDraftConfig{Strategy: MockStrategyNeed}, nil, nil)
+	if pool[chosen[0]].Name != "Two" {
+		t.Fatalf("expected the need strategy to take the best WR, got %s", pool[chosen[0]].Name)
+	}
+}
+
+func TestRunMockDraftsAvailability(t *testing.T) {
+	pool := mockTestPool()
+	order := mockSnakeOrder([]int{1, 2, 3}, 2, "snake", 0)
+	outlook, sample, haul := runMockDrafts(order, pool, mockTestTeams(), MockDraftConfig{Strategy: MockStrategyBPA, Simulations: 20, Seed: 1})
+	if len(outlook) != 2 || outlook[0].PickNo != 3 || outlook[1].PickNo != 4 {
+		t.Fatalf("expected an outlook at both user picks, got %+v", outlook)
+	}
+	first := outlook[0].Players
+	if len(first) != 4 || first[0].Name != "Three" || first[0].AvailablePct != 100 || first[0].TakenPct != 100 || first[1].TakenPct != 0 {
+		t.Fatalf("unexpected availability at the first user pick: %+v", first)
+	}
+	if len(sample) != 6 || !sample[2].IsUser || sample[2].TeamName != "Me" {
+		t.Fatalf("unexpected sample draft: %+v", sample)
+	}
+	if haul != 7000+6000 {
+		t.Fatalf("expected the user to haul Three and Four, got %d", haul)
+	}
+
+	// With randomness the top players are sometimes there and sometimes not, repeatably per seed
+	cfg := MockDraftConfig{Strategy: MockStrategyBPA, Randomness: 1, Simulations: 300, Seed: 7}
+	a, _, _ := runMockDrafts(order, pool, mockTestTeams(), cfg)
+	b, _, _ := runMockDrafts(order, pool, mockTestTeams(), cfg)
+	if fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Fatalf("expected the same seed to repeat")
+	}
+	if a[0].Players[0].Name == "Three" && a[0].Players[0].AvailablePct == 100 {
+		t.Fatalf("expected randomness to vary who is available: %+v", a[0].Players)
+	}
+}
+
+func TestParseMockDraftConfigBounds(t *testing.T) {
+	cfg := parseMockDraftConfig(url.Values{"randomness": {"3"}, "sims": {"999999"}, "seed": {"42"}}, "L1", "me")
+	if cfg.Randomness != 1 || cfg.Simulations != MOCK_DRAFT_MAX_SIMULATIONS || cfg.Seed != 42 || cfg.Strategy != MockStrategyBPA {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+	def := parseMockDraftConfig(url.Values{}, "L1", "me")
+	if def.Randomness != MOCK_DRAFT_RANDOMNESS || def.Simulations != MOCK_DRAFT_SIMULATIONS || def.Seed != parseMockDraftConfig(url.Values{}, "L1", "ME").Seed {
+		t.Fatalf("unexpected defaults: %+v", def)
+	}
+}
+
+func TestBuildMockDraftStartup(t *testing.T) {
+	room := draftRoomTestInputs()
+	page := buildMockDraft(mockDraftInputs{
+		League:          room.League,
+		Draft:           room.Draft,
+		Rosters:         room.Rosters,
+		UserNames:       room.UserNames,
+		UserID:          room.UserID,
+		Players:         room.Players,
+		Tiers:           room.Tiers,
+		RosterPositions: room.RosterPositions,
+	}, MockDraftConfig{Strategy: MockStrategyBPA, Simulations: 10, Seed: 3})
+	if page.Mode != "startup" || page.Teams != 3 || page.Rounds != 5 || page.OrderSource != "snake in roster order" {
+		t.Fatalf("unexpected startup setup: %+v", page)
+	}
+	if len(page.Sample) != 6 || len(page.Outlook) != 5 {
+		t.Fatalf("expected the six-player pool to run dry: %d picks, %d user picks", len(page.Sample), len(page.Outlook))
+	}
+	if fmt.Sprint(page.UserNeeds) != "[QB RB WR TE]" {
+		t.Fatalf("unexpected user needs: %v", page.UserNeeds)
+	}
+}
+
+func TestBuildMockDraftRookie(t *testing.T) {
+	values := map[string]DynastyValue{}
+	for i := 1; i <= 12; i++ {
+		name := fmt.Sprintf("Rookie %d", i)
+		values[normalizeName(name)] = DynastyValue{Name: name, Position: "WR", DraftYear: 2026, Value1QB: 5000 - i*100}
+	}
+	roster := func(id float64, owner string, wins float64) map[string]interface{} {
+		return map[string]interface{}{"roster_id": id, "owner_id": owner, "settings": map[string]interface{}{"wins": wins}}
+	}
+	in := mockDraftInputs{
+		League: map[string]interface{}{
+			"league_id": "L1", "season": "2026", "status": "pre_draft",
+			"settings": map[string]interface{}{"type": float64(2), "draft_rounds": float64(1)},
+		},
+		Rosters:       []map[string]interface{}{roster(1, "u1", 5), roster(2, "u2", 1), roster(3, "u3", 3)},
+		TradedPicks:   []map[string]interface{}{{"season": "2026", "round": float64(1), "roster_id": float64(3), "owner_id": float64(1)}},
+		UserNames:     map[string]string{"u1": "Me", "u2": "Worst", "u3": "Middle"},
+		UserID:        "u1",
+		DynastyValues: values,
+	}
+	page := buildMockDraft(in, MockDraftConfig{Strategy: MockStrategyBPA, Simulations: 5, Seed: 1})
+	if page.Mode != "rookie" || page.DraftYear != 2026 || page.BoardSource != "dynasty values" {
+		t.Fatalf("unexpected rookie setup: %+v", page)
+	}
+	if len(page.Sample) != 3 || page.Sample[0].TeamName != "Worst" || page.Sample[0].Player != "Rookie 1" || !page.Sample[1].IsUser || !page.Sample[2].IsUser {
+		t.Fatalf("unexpected rookie draft: %+v", page.Sample)
+	}
+	if len(page.Outlook) != 2 || page.Outlook[0].PickNo != 2 {
+		t.Fatalf("expected outlooks at the user's two picks: %+v", page.Outlook)
+	}
+
+	// A projected draft order slots the picks under the league's method
+	in.DraftOrder = &draftOrderProjection{Method: DraftOrderLottery, Source: "final standings, simulated lottery", Teams: 3, SlotOdds: map[int][]float64{
+		1: {0, 10, 90}, 2: {30, 70, 0}, 3: {70, 20, 10},
+	}}
+	page = buildMockDraft(in, MockDraftConfig{Strategy: MockStrategyBPA, Simulations: 5, Seed: 1})
+	if page.OrderSource != "pick ownership, slotted by non-playoff lottery (final standings, simulated lottery)" || len(page.Sample) != 3 || !page.Sample[0].IsUser || page.Sample[1].TeamName != "Worst" {
+		t.Fatalf("expected the projected order: %s %+v", page.OrderSource, page.Sample)
+	}
+
+	// The league's Sleeper draft for the year sets the format and order
+	in.Draft = map[string]interface{}{
+		"draft_id": "D1", "season": "2026", "type": "snake",
+		"settings":          map[string]interface{}{"rounds": float64(2)},
+		"slot_to_roster_id": map[string]interface{}{"1": float64(3), "2": float64(1), "3": float64(2)},
+	}
+	page = buildMockDraft(in, MockDraftConfig{Strategy: MockStrategyBPA, Simulations: 5, Seed: 1})
+	teams := []string{}
+	for _, p := range page.Sample {
+		teams = append(teams, p.TeamName)
+	}
+	if page.Rounds != 2 || page.OrderSource != "Sleeper draft order and pick ownership" || fmt.Sprint(teams) != "[Me Me Worst Worst Me Middle]" {
+		t.Fatalf("expected a two-round snake in the Sleeper order: %s %v", page.OrderSource, teams)
+	}
+}
+
+func TestMockDraftHandlerRequiresLeague(t *testing.T) {
+	rec := httptest.NewRecorder()
+	mockDraftHandler(rec, httptest.NewRequest(http.MethodGet, "/mock-draft", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without league_id, got %d", rec.Code)
+	}
+}
@@ -61,6 +61,12 @@ func mockAPIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Playoff brackets: not set yet
+	if strings.HasSuffix(path, "_bracket") {
+		json.NewEncoder(w).Encode([]map[string]interface{}{})
+		return
+	}
+
 	// Rosters
 	if strings.Contains(path, "/rosters") {
 		rosters := getMockRosters()
@@ -75,9 +81,41 @@ func mockAPIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// NFL schedule: no games, so no bye weeks
+	if strings.Contains(path, "/schedule/nfl/") {
+		json.NewEncoder(w).Encode([]map[string]interface{}{})
+		return
+	}
+
+	// Drafts: one live snake draft with no picks or trades yet
+	if strings.Contains(path, "/picks") || strings.HasSuffix(path, "/traded_picks") {
+		json.NewEncoder(w).Encode([]map[string]interface{}{})
+		return
+	}
+	if strings.Contains(path, "/drafts") || strings.Contains(path, "/draft/") {
+		draft := getMockDraft()
+		if strings.Contains(path, "/drafts") {
+			json.NewEncoder(w).Encode([]map[string]interface{}{draft})
+			return
+		}
+		json.NewEncoder(w).Encode(draft)
+		return
+	}
+
 	http.Error(w, "Mock endpoint not found", 404)
 }
 
+// Mock draft - user picks third in a 12-team snake draft
+func getMockDraft() map[string]interface{} {
+	return map[string]interface{}{
+		"draft_id":    "mock_draft_1",
+		"status":      "drafting",
+		"type":        "snake",
+		"settings":    map[string]interface{}{"teams": 12.0, "rounds": 15.0},
+		"draft_order": map[string]interface{}{"test_user_testuser": 3.0},
+	}
+}
+
 // Boris Chen tier files handler for test mode
 func mockBorisTiersHandler(w http.ResponseWriter, r *http.Request) {
 	if !testMode {
@@ -283,7 +321,7 @@ func (t *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	mockHost := "localhost:" + getServerPort()
 
 	// Intercept Sleeper API calls
-	if strings.Contains(req.URL.Host, "api.sleeper.app") {
+	if strings.Contains(req.URL.Host, "api.sleeper.app") || strings.Contains(req.URL.Host, "api.sleeper.com") {
 		// Rewrite to local mock server
 		req.URL.Scheme = "http"
 		req.URL.Host = mockHost
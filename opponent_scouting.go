@@ -0,0 +1,134 @@
+// ABOUTME: Opponent scouting report for the current week's matchup
+// ABOUTME: Compares positional tiers, surfaces injuries and recent moves, and tracks head-to-head history
+
+package main
+
+import "sort"
+
+const POSITION_EDGE_THRESHOLD = 0.5 // Minimum average tier gap to call a positional edge
+
+// matchupOpponent finds the opponent for a roster in a week's matchups.
+// Returns the opponent's roster ID plus both teams' points.
+func matchupOpponent(matchups []map[string]interface{}, rosterID int) (int, float64, float64, bool) {
+	var mine map[string]interface{}
+	for _, m := range matchups {
+		if rid, ok := m["roster_id"].(float64); ok && int(rid) == rosterID {
+			mine = m
+			break
+		}
+	}
+	if mine == nil || mine["matchup_id"] == nil {
+		return 0, 0, 0, false
+	}
+	for _, m := range matchups {
+		rid, _ := m["roster_id"].(float64)
+		if int(rid) != rosterID && m["matchup_id"] == mine["matchup_id"] {
+			return int(rid), matchupPoints(mine), matchupPoints(m), true
+		}
+	}
+	return 0, 0, 0, false
+}
+
+func matchupPoints(m map[string]interface{}) float64 {
+	points, _ := m["points"].(float64)
+	return points
+}
+
+// calculateHeadToHead walks completed weeks and records every meeting between two rosters
+func calculateHeadToHead(weekly map[int][]map[string]interface{}, userRosterID, oppRosterID int) HeadToHeadRecord {
+	record := HeadToHeadRecord{}
+	weeks := make([]int, 0, len(weekly))
+	for w := range weekly {
+		weeks = append(weeks, w)
+	}
+	sort.Ints(weeks)
+
+	for _, w := range weeks {
+		opp, yours, theirs, ok := matchupOpponent(weekly[w], userRosterID)
+		if !ok || opp != oppRosterID || (yours == 0 && theirs == 0) {
+			continue
+		}
+		game := HeadToHeadGame{Week: w, YourPoints: yours, TheirPoints: theirs}
+		switch {
+		case yours > theirs:
+			game.Result = "W"
+			record.Wins++
+		case yours < theirs:
+			game.Result = "L"
+			record.Losses++
+		default:
+			game.Result = "T"
+			record.Ties++
+		}
+		record.Games = append(record.Games, game)
+	}
+	return record
+}
+
+// comparePositionalTiers compares average starter tiers by actual player position
+func comparePositionalTiers(userStarters, oppStarters []PlayerRow) []PositionEdge {
+	edges := []PositionEdge{}
+	for _, pos := range []string{"QB", "RB", "WR", "TE"} {
+		yours := averageTierAt(userStarters, pos)
+		theirs := averageTierAt(oppStarters, pos)
+		if yours == 0 || theirs == 0 {
+			continue
+		}
+		edge := "Even"
+		if theirs-yours >= POSITION_EDGE_THRESHOLD {
+			edge = "You" // Lower tier number is better
+		} else if yours-theirs >= POSITION_EDGE_THRESHOLD {
+			edge = "Them"
+		}
+		edges = append(edges, PositionEdge{Position: pos, YourAvgTier: yours, TheirAvgTier: theirs, Edge: edge})
+	}
+	return edges
+}
+
+func averageTierAt(rows []PlayerRow, pos string) float64 {
+	total := 0.0
+	count := 0
+	for _, row := range rows {
+		if row.Pos != pos {
+			continue
+		}
+		if t := parseTierFloat(row.Tier); t > 0 {
+			total += t
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+// collectInjuries returns players carrying an injury designation
+func collectInjuries(rows ...[]PlayerRow) []PlayerRow {
+	injured := []PlayerRow{}
+	for _, list := range rows {
+		for _, row := range list {
+			if row.InjuryStatus != "" {
+				injured = append(injured, row)
+			}
+		}
+	}
+	return injured
+}
+
+// filterTransactionsByTeam returns the most recent transactions involving a team
+func filterTransactionsByTeam(txns []Transaction, teamName string, limit int) []Transaction {
+	result := []Transaction{}
+	for _, txn := range txns {
+		for _, name := range txn.TeamNames {
+			if name == teamName {
+				result = append(result, txn)
+				break
+			}
+		}
+		if len(result) >= limit {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package main
+
+import "testing"
+
+func matchupEntry(rosterID, matchupID int, points float64) map[string]interface{} {
+	return map[string]interface{}{
+		"roster_id":  float64(rosterID),
+		"matchup_id": float64(matchupID),
+		"points":     points,
+	}
+}
+
+func TestMatchupOpponent(t *testing.T) {
+	matchups := []map[string]interface{}{
+		matchupEntry(1, 1, 110.5),
+		matchupEntry(2, 2, 90),
+		matchupEntry(3, 1, 98.2),
+		matchupEntry(4, 2, 101),
+	}
+	opp, yours, theirs, ok := matchupOpponent(matchups, 1)
+	if !ok || opp != 3 || yours != 110.5 || theirs != 98.2 {
+		t.Fatalf("unexpected opponent result: %d %.1f %.1f %v", opp, yours, theirs, ok)
+	}
+	if _, _, _, ok := matchupOpponent(matchups, 9); ok {
+		t.Fatalf("expected no opponent for unknown roster")
+	}
+}
+
+func TestCalculateHeadToHead(t *testing.T) {
+	weekly := map[int][]map[string]interface{}{
+		1: {matchupEntry(1, 1, 120), matchupEntry(2, 1, 100)},
+		2: {matchupEntry(1, 1, 95), matchupEntry(3, 1, 99)},
+		5: {matchupEntry(1, 2, 80), matchupEntry(2, 2, 104)},
+	}
+	record := calculateHeadToHead(weekly, 1, 2)
+	if record.Wins != 1 || record.Losses != 1 || record.Ties != 0 {
+		t.Fatalf("expected 1-1 record, got %d-%d-%d", record.Wins, record.Losses, record.Ties)
+	}
+	if len(record.Games) != 2 || record.Games[0].Week != 1 || record.Games[1].Result != "L" {
+		t.Fatalf("unexpected game log: %+v", record.Games)
+	}
+}
+
+func TestComparePositionalTiers(t *testing.T) {
+	user := []PlayerRow{{Pos: "QB", Tier: 2}, {Pos: "RB", Tier: 5}, {Pos: "RB", Tier: 7}}
+	opp := []PlayerRow{{Pos: "QB", Tier: 4}, {Pos: "RB", Tier: 3}, {Pos: "RB", Tier: 4}}
+	edges := comparePositionalTiers(user, opp)
+	if len(edges) != 2 {
+		t.Fatalf("expected QB and RB edges, got %+v", edges)
+	}
+	if edges[0].Position != "QB" || edges[0].Edge != "You" {
+		t.Fatalf("expected QB edge to user, got %+v", edges[0])
+	}
+	if edges[1].Position != "RB" || edges[1].Edge != "Them" {
+		t.Fatalf("expected RB edge to opponent, got %+v", edges[1])
+	}
+}
+
+func TestFilterTransactionsByTeam(t *testing.T) {
+	txns := []Transaction{
+		{Description: "a", TeamNames: []string{"Sharks"}},
+		{Description: "b", TeamNames: []string{"Jets", "Sharks"}},
+		{Description: "c", TeamNames: []string{"Jets"}},
+	}
+	got := filterTransactionsByTeam(txns, "Sharks", 5)
+	if len(got) != 2 || got[1].Description != "b" {
+		t.Fatalf("unexpected filtered transactions: %+v", got)
+	}
+}
@@ -0,0 +1,415 @@
+// ABOUTME: Rookie draft pick valuation from DynastyProcess pick values or a configurable decay curve
+// ABOUTME: Adjusts for projected slot and discounts future years so picks count toward roster and trade value
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DYNASTY_PICK_VALUES_URL = "https://raw.githubusercontent.com/dynastyprocess/data/master/files/values-picks.csv"
+
+// FAAB_BUDGET_PICK_ROUND prices a full FAAB budget like a mid-round pick in this round of the upcoming draft
+const FAAB_BUDGET_PICK_ROUND = 2
+
+// PickCurve values picks when DynastyProcess has no matching label:
+// value = Top * e^(-Decay * (overall pick - 1)), with overall scaled to a 12-team draft
+type PickCurve struct {
+	Top          float64 // Value of the 1.01
+	Decay        float64 // Exponential decay per overall pick
+	YearDiscount float64 // Multiplier per year beyond the upcoming draft
+}
+
+var defaultPickCurve = PickCurve{Top: 7000, Decay: 0.045, YearDiscount: 0.85}
+
+// pickCurveFromEnv applies PICK_CURVE_TOP, PICK_CURVE_DECAY, and PICK_YEAR_DISCOUNT overrides
+func pickCurveFromEnv() PickCurve {
+	c := defaultPickCurve
+	for env, field := range map[string]*float64{
+		"PICK_CURVE_TOP":     &c.Top,
+		"PICK_CURVE_DECAY":   &c.Decay,
+		"PICK_YEAR_DISCOUNT": &c.YearDiscount,
+	} {
+		raw := strings.TrimSpace(os.Getenv(env))
+		if raw == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || v <= 0 {
+			log.Printf("[ERROR] Invalid %s %q", env, raw)
+			continue
+		}
+		*field = v
+	}
+	return c
+}
+
+// fetchDynastyPickValues loads DynastyProcess pick values keyed by lowercased label
+// (e.g. "2026 pick 1.01", "2026 early 1st", "2027 1st"). A failed download is cached as nil
+// for failureTTL so an outage isn't retried on every lookup.
+func fetchDynastyPickValues() map[string]DynastyValue {
+	dynastyPickValuesCache.RLock()
+	data, age := dynastyPickValuesCache.data, time.Since(dynastyPickValuesCache.timestamp)
+	if data == nil && age < dynastyPickValuesCache.failureTTL {
+		dynastyPickValuesCache.RUnlock()
+		return nil
+	}
+	if age < dynastyPickValuesCache.ttl && len(data) > 0 {
+		debugLog("[DEBUG] Using cached dynasty pick values")
+		dynastyPickValuesCache.RUnlock()
+		return data
+	}
+	dynastyPickValuesCache.RUnlock()
+
+	debugLog("[DEBUG] Fetching fresh dynasty pick values from DynastyProcess")
+	values, err := downloadDynastyPickValues()
+	if err != nil {
+		log.Printf("[ERROR] Failed to fetch dynasty pick values: %v", err)
+		totalErrors.Inc()
+		values = nil
+	} else {
+		debugLog("[DEBUG] Loaded %d dynasty pick values", len(values))
+	}
+
+	dynastyPickValuesCache.Lock()
+	dynastyPickValuesCache.data = values
+	dynastyPickValuesCache.timestamp = time.Now()
+	dynastyPickValuesCache.Unlock()
+	return values
+}
+
+// downloadDynastyPickValues fetches and parses the DynastyProcess pick values file
+func downloadDynastyPickValues() (map[string]DynastyValue, error) {
+	resp, err := httpClient.Get(DYNASTY_PICK_VALUES_URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parsePickValuesCSV(string(body)), nil
+}
+
+// parsePickValuesCSV reads the pick file by header name, falling back to the player file's column order
+func parsePickValuesCSV(body string) map[string]DynastyValue {
+	values := make(map[string]DynastyValue)
+	lines := strings.Split(body, "\n")
+	if len(lines) == 0 {
+		return values
+	}
+	nameCol, oneQBCol, twoQBCol := 0, 1, 2
+	for i, h := range parseCSVLine(strings.TrimSpace(lines[0])) {
+		switch strings.ToLower(strings.Trim(h, "\" ")) {
+		case "player":
+			nameCol = i
+		case "value_1qb":
+			oneQBCol = i
+		case "value_2qb":
+			twoQBCol = i
+		}
+	}
+
+	for _, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := parseCSVLine(line)
+		if len(fields) <= nameCol || len(fields) <= oneQBCol || len(fields) <= twoQBCol {
+			continue
+		}
+		label := strings.Trim(fields[nameCol], "\"")
+		v1, err1 := strconv.ParseFloat(strings.Trim(fields[oneQBCol], "\""), 64)
+		v2, err2 := strconv.ParseFloat(strings.Trim(fields[twoQBCol], "\""), 64)
+		if label == "" || err1 != nil || err2 != nil {
+			continue
+		}
+		values[pickLabelKey(label)] = DynastyValue{
+			Name:     label,
+			Position: "PICK",
+			Value1QB: int(math.Round(v1)),
+			Value2QB: int(math.Round(v2)),
+		}
+	}
+	return values
+}
+
+func pickLabelKey(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), " ")
+}
+
+// ordinal renders a round as "1st", "2nd", "3rd", "4th"...
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// pickValuer values a league's picks on the same scale as player dynasty values
+type pickValuer struct {
+	values      map[string]DynastyValue
+	isSuperFlex bool
+	teams       int
+	draftYear   int // Upcoming rookie draft; later years are discounted
+	curve       PickCurve
+	faabBudget  int // League FAAB budget; 0 values traded FAAB at nothing
+}
+
+func newPickValuer(values map[string]DynastyValue, isSuperFlex bool, teams, draftYear int) *pickValuer {
+	if teams <= 0 {
+		teams = 12
+	}
+	return &pickValuer{values: values, isSuperFlex: isSuperFlex, teams: teams, draftYear: draftYear, curve: pickCurveFromEnv()}
+}
+
+// value returns a pick's worth. slot is the projected position within the round (1 = first);
+// 0 means unknown and values the pick as a mid-round selection.
+func (v *pickValuer) value(year, round, slot int) int {
+	if v == nil || round <= 0 {
+		return 0
+	}
+	yearsOut := year - v.draftYear
+	if yearsOut < 0 {
+		yearsOut = 0
+	}
+	// DynastyProcess prices each listed year itself, so a direct hit needs no discount
+	if dv, ok := v.lookup(year, round, slot); ok {
+		return dv
+	}
+	discount := math.Pow(v.curve.YearDiscount, float64(yearsOut))
+	if dv, ok := v.lookup(v.draftYear, round, slot); ok && yearsOut > 0 {
+		return int(math.Round(float64(dv) * discount))
+	}
+
+	if slot <= 0 || slot > v.teams {
+		slot = (v.teams + 1) / 2
+	}
+	overall := float64((round-1)*v.teams + slot)
+	overall12 := (overall-1)*12/float64(v.teams) + 1
+	return int(math.Round(v.curve.Top * math.Exp(-v.curve.Decay*(overall12-1)) * discount))
+}
+
+// lookup tries the exact slot, then early/mid/late, then the generic round label
+func (v *pickValuer) lookup(year, round, slot int) (int, bool) {
+	if len(v.values) == 0 {
+		return 0, false
+	}
+	keys := []string{}
+	if slot > 0 && slot <= v.teams {
+		slot12 := int(math.Round(float64(slot-1)*11/math.Max(1, float64(v.teams-1)))) + 1
+		keys = append(keys, fmt.Sprintf("%d pick %d.%02d", year, round, slot12))
+		third := "mid"
+		if slot12 <= 4 {
+			third = "early"
+		} else if slot12 >= 9 {
+			third = "late"
+		}
+		keys = append(keys, fmt.Sprintf("%d %s %s", year, third, ordinal(round)))
+	}
+	keys = append(keys, fmt.Sprintf("%d mid %s", year, ordinal(round)), fmt.Sprintf("%d %s", year, ordinal(round)))
+	for _, k := range keys {
+		if dv, ok := v.values[k]; ok {
+			if v.isSuperFlex {
+				return dv.Value2QB, true
+			}
+			return dv.Value1QB, true
+		}
+	}
+	return 0, false
+}
+
+// assetValue values a non-player trade asset: a pick like "2026 Round 1 (from Team X)" or FAAB
+// like "$25 FAAB". Players return 0. Trades don't record the slot, so picks are valued as mid-round.
+func (v *pickValuer) assetValue(asset string) int {
+	if amount, ok := parseFAABAsset(asset); ok {
+		return v.faabValue(amount)
+	}
+	year, round, ok := parsePickAsset(asset)
+	if !ok || v.drafted(asset) {
+		// A drafted pick has become a player, so it has no pick value of its own left
+		return 0
+	}
+	return v.value(year, round, 0)
+}
+
+// drafted reports whether a "YYYY Round N" pick belongs to a draft that has already happened
+func (v *pickValuer) drafted(asset string) bool {
+	year, _, ok := parsePickAsset(asset)
+	return ok && v != nil && year < v.draftYear
+}
+
+// faabValue scales FAAB dollars against a full budget priced as a mid-round FAAB_BUDGET_PICK_ROUND pick
+func (v *pickValuer) faabValue(amount int) int {
+	if v == nil || v.faabBudget <= 0 || amount <= 0 {
+		return 0
+	}
+	full := float64(v.value(v.draftYear, FAAB_BUDGET_PICK_ROUND, 0))
+	return int(math.Round(full * float64(amount) / float64(v.faabBudget)))
+}
+
+// parsePickAsset reads the year and round from a "YYYY Round N" trade asset
+func parsePickAsset(asset string) (int, int, bool) {
+	var year, round int
+	if _, err := fmt.Sscanf(asset, "%d Round %d", &year, &round); err != nil || year == 0 || round == 0 {
+		return 0, 0, false
+	}
+	return year, round, true
+}
+
+// faabAsset names FAAB dollars sent in a trade
+func faabAsset(amount int) string {
+	return fmt.Sprintf("$%d FAAB", amount)
+}
+
+// parseFAABAsset reads the dollars from a "$N FAAB" trade asset
+func parseFAABAsset(asset string) (int, bool) {
+	var amount int
+	if _, err := fmt.Sscanf(asset, "$%d FAAB", &amount); err != nil || amount <= 0 {
+		return 0, false
+	}
+	return amount, true
+}
+
+// isPickOrFAABAsset reports whether a trade asset is a draft pick or FAAB rather than a player
+func isPickOrFAABAsset(asset string) bool {
+	if _, ok := parseFAABAsset(asset); ok {
+		return true
+	}
+	_, _, ok := parsePickAsset(asset)
+	return ok
+}
+
+// pickSlotsFromStandings projects each roster's draft slot: worst record picks first
+func pickSlotsFromStandings(standings []StandingsEntry) map[int]int {
+	slots := make(map[int]int, len(standings))
+	for _, e := range standings {
+		if e.Rank > 0 {
+			slots[e.RosterID] = len(standings) - e.Rank + 1
+		}
+	}
+	return slots
+}
+
+// ownedPick is one entry of a buildPickOwnership map
+type ownedPick struct {
+	Year, Round, OriginalRosterID, Owner int
+}
+
+func parseOwnership(ownership map[string]int) []ownedPick {
+	picks := make([]ownedPick, 0, len(ownership))
+	for key, owner := range ownership {
+		var p ownedPick
+		if _, err := fmt.Sscanf(key, "%d-%d-%d", &p.Year, &p.Round, &p.OriginalRosterID); err != nil {
+			continue
+		}
+		p.Owner = owner
+		picks = append(picks, p)
+	}
+	sort.Slice(picks, func(i, j int) bool {
+		if picks[i].Year != picks[j].Year {
+			return picks[i].Year < picks[j].Year
+		}
+		if picks[i].Round != picks[j].Round {
+			return picks[i].Round < picks[j].Round
+		}
+		return picks[i].OriginalRosterID < picks[j].OriginalRosterID
+	})
+	return picks
+}
+
+// slotFor projects a slot only for the upcoming draft; later drafts are too far out to call
+func (v *pickValuer) slotFor(year, originalRosterID int, slots map[int]int) int {
+	if v == nil || year != v.draftYear {
+		return 0
+	}
+	return slots[originalRosterID]
+}
+
+// pickValueByRoster totals the value of every pick each roster currently owns
+func pickValueByRoster(ownership map[string]int, slots map[int]int, valuer *pickValuer) map[int]float64 {
+	totals := map[int]float64{}
+	for _, p := range parseOwnership(ownership) {
+		totals[p.Owner] += float64(valuer.value(p.Year, p.Round, valuer.slotFor(p.Year, p.OriginalRosterID, slots)))
+	}
+	return totals
+}
+
+// rosterPickAssets lists one roster's picks as trade-coach assets, most valuable first
+func rosterPickAssets(ownership map[string]int, rosterID int, slots map[int]int, valuer *pickValuer) []ProposalPlayer {
+	assets := []ProposalPlayer{}
+	for _, p := range parseOwnership(ownership) {
+		if p.Owner != rosterID {
+			continue
+		}
+		slot := valuer.slotFor(p.Year, p.OriginalRosterID, slots)
+		value := valuer.value(p.Year, p.Round, slot)
+		if value <= 0 {
+			continue
+		}
+		name := fmt.Sprintf("%d Round %d", p.Year, p.Round)
+		if slot > 0 {
+			name = fmt.Sprintf("%d Pick %d.%02d", p.Year, p.Round, slot)
+		}
+		assets = append(assets, ProposalPlayer{Name: name, Position: "PICK", DynastyValue: value})
+	}
+	sort.SliceStable(assets, func(i, j int) bool { return assets[i].DynastyValue > assets[j].DynastyValue })
+	return assets
+}
+
+// valueDraftPicks fills in Value on the user's picks and projections and returns the user's total.
+// Projections with a slot distribution are valued at the probability-weighted slot value.
+// DraftPick.RosterID is the owner, so upcoming-draft values come from the projections when present.
+func valueDraftPicks(draftPicks []DraftPick, projected []ProjectedDraftPick, valuer *pickValuer) int {
+	if valuer == nil {
+		return 0
+	}
+	projectedValue := map[string]int{}
+	for i := range projected {
+		p := &projected[i]
+		p.Value = valuer.value(p.Year, p.Round, p.ProjectedPosition)
+		if len(p.SlotOdds) > 0 {
+			expected, total := 0.0, 0.0
+			for _, o := range p.SlotOdds {
+				expected += o.Pct * float64(valuer.value(p.Year, p.Round, o.Slot))
+				total += o.Pct
+			}
+			p.Value = int(math.Round(expected / total))
+		}
+		projectedValue[fmt.Sprintf("%d-%d-%s", p.Year, p.Round, p.OriginalOwner)] = p.Value
+	}
+	total := 0
+	for i := range draftPicks {
+		d := &draftPicks[i]
+		if v, ok := projectedValue[fmt.Sprintf("%d-%d-%s", d.Year, d.Round, d.OriginalName)]; ok {
+			d.Value = v
+		} else {
+			d.Value = valuer.value(d.Year, d.Round, 0)
+		}
+		if d.IsYours {
+			total += d.Value
+		}
+	}
+	return total
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePickValuesCSV(t *testing.T) {
+	csv := "\"player\",\"pick\",\"value_1qb\",\"value_2qb\",\"scrape_date\"\n" +
+		"\"2026 Pick 1.01\",\"1.01\",\"6500\",\"8000.4\",\"2026-03-01\"\n" +
+		"\"2026 Early 1st\",\"\",\"5200\",\"6100\",\"2026-03-01\"\n" +
+		"\"2027 1st\",\"\",\"4000\",\"4500\",\"2026-03-01\"\n" +
+		"bad,row\n"
+	values := parsePickValuesCSV(csv)
+	if len(values) != 3 {
+		t.Fatalf("expected 3 pick values, got %d: %v", len(values), values)
+	}
+	if dv := values["2026 pick 1.01"]; dv.Value1QB != 6500 || dv.Value2QB != 8000 {
+		t.Fatalf("unexpected 1.01 value: %+v", dv)
+	}
+	if _, ok := values["2026 early 1st"]; !ok {
+		t.Fatalf("expected lowercased early 1st key, got %v", values)
+	}
+}
+
+func TestPickValuerLookupOrder(t *testing.T) {
+	values := map[string]DynastyValue{
+		"2026 pick 1.01": {Value1QB: 6500, Value2QB: 8000},
+		"2026 late 1st":  {Value1QB: 3000, Value2QB: 3500},
+		"2026 2nd":       {Value1QB: 1200, Value2QB: 1300},
+	}
+	v := newPickValuer(values, false, 12, 2026)
+
+	if got := v.value(2026, 1, 1); got != 6500 {
+		t.Fatalf("expected exact slot value, got %d", got)
+	}
+	if got := v.value(2026, 1, 11); got != 3000 {
+		t.Fatalf("expected late 1st value, got %d", got)
+	}
+	if got := v.value(2026, 2, 0); got != 1200 {
+		t.Fatalf("expected generic 2nd value, got %d", got)
+	}
+	// 2028 isn't listed: fall back to the 2026 2nd discounted two years
+	if got := v.value(2028, 2, 0); got != 867 {
+		t.Fatalf("expected discounted 2nd value, got %d", got)
+	}
+
+	sf := newPickValuer(values, true, 12, 2026)
+	if got := sf.value(2026, 1, 1); got != 8000 {
+		t.Fatalf("expected superflex value, got %d", got)
+	}
+
+	// Slots are scaled to a 12-team board: slot 10 of 10 is the 1.12 (late)
+	ten := newPickValuer(values, false, 10, 2026)
+	if got := ten.value(2026, 1, 10); got != 3000 {
+		t.Fatalf("expected 10-team last pick to map to late 1st, got %d", got)
+	}
+}
+
+func TestPickValuerCurve(t *testing.T) {
+	t.Setenv("PICK_CURVE_TOP", "1000")
+	t.Setenv("PICK_YEAR_DISCOUNT", "0.5")
+	v := newPickValuer(nil, false, 12, 2026)
+
+	if got := v.value(2026, 1, 1); got != 1000 {
+		t.Fatalf("expected curve top for 1.01, got %d", got)
+	}
+	early, late := v.value(2026, 1, 2), v.value(2026, 1, 12)
+	if early <= late || late <= v.value(2026, 2, 1) {
+		t.Fatalf("expected value to fall with each pick: 1.02=%d 1.12=%d", early, late)
+	}
+	if got := v.value(2027, 1, 1); got != 500 {
+		t.Fatalf("expected one year of discount, got %d", got)
+	}
+	if got := v.value(2026, 1, 0); got != v.value(2026, 1, 6) {
+		t.Fatalf("expected unknown slot to value as mid-round, got %d", got)
+	}
+
+	var none *pickValuer
+	if none.value(2026, 1, 1) != 0 || none.assetValue("2026 Round 1") != 0 {
+		t.Fatalf("expected nil valuer to value nothing")
+	}
+}
+
+func TestPickAssetsAndRosterTotals(t *testing.T) {
+	if year, round, ok := parsePickAsset("2027 Round 2 (from Team X)"); !ok || year != 2027 || round != 2 {
+		t.Fatalf("unexpected parse: %d %d %v", year, round, ok)
+	}
+	if _, _, ok := parsePickAsset("Justin Jefferson"); ok {
+		t.Fatalf("expected player name not to parse as a pick")
+	}
+
+	t.Setenv("PICK_CURVE_TOP", "1000")
+	v := newPickValuer(nil, false, 2, 2026)
+	standings := []StandingsEntry{{RosterID: 1, Rank: 1}, {RosterID: 2, Rank: 2}}
+	slots := pickSlotsFromStandings(standings)
+	if slots[1] != 2 || slots[2] != 1 {
+		t.Fatalf("expected worst team to pick first, got %v", slots)
+	}
+
+	// Roster 1 owns both 2026 firsts; roster 2's pick is the 1.01
+	ownership := map[string]int{"2026-1-1": 1, "2026-1-2": 1, "2027-1-2": 2}
+	totals := pickValueByRoster(ownership, slots, v)
+	want := float64(v.value(2026, 1, 1) + v.value(2026, 1, 2))
+	if totals[1] != want || totals[2] != float64(v.value(2027, 1, 0)) {
+		t.Fatalf("unexpected pick totals: %v", totals)
+	}
+
+	assets := rosterPickAssets(ownership, 1, slots, v)
+	if len(assets) != 2 || assets[0].Name != "2026 Pick 1.01" || assets[0].Position != "PICK" {
+		t.Fatalf("unexpected pick assets: %+v", assets)
+	}
+}
+
+func TestValueDraftPicks(t *testing.T) {
+	v := newPickValuer(nil, false, 12, 2026)
+	draftPicks := []DraftPick{
+		{Year: 2026, Round: 1, IsYours: true},
+		{Year: 2026, Round: 1, OriginalName: "Rival", IsYours: true},
+		{Year: 2027, Round: 1, IsYours: true},
+	}
+	projected := []ProjectedDraftPick{
+		{Year: 2026, Round: 1, ProjectedPosition: 12},
+		{Year: 2026, Round: 1, ProjectedPosition: 1, OriginalOwner: "Rival"},
+	}
+	total := valueDraftPicks(draftPicks, projected, v)
+	if draftPicks[1].Value != v.value(2026, 1, 1) || projected[0].Value != v.value(2026, 1, 12) {
+		t.Fatalf("expected projected slots to drive values: %+v %+v", draftPicks, projected)
+	}
+	if total != draftPicks[0].Value+draftPicks[1].Value+draftPicks[2].Value {
+		t.Fatalf("unexpected total %d", total)
+	}
+}
+
+func TestBalanceWithPicks(t *testing.T) {
+	yourOffer := []ProposalPlayer{{Name: "A", DynastyValue: 4000}}
+	theirReturn := []ProposalPlayer{{Name: "B", DynastyValue: 5000}}
+	yourPicks := []ProposalPlayer{{Name: "2026 Round 1", Position: "PICK", DynastyValue: 3000}, {Name: "2026 Round 2", Position: "PICK", DynastyValue: 900}}
+
+	you, them := balanceWithPicks(yourOffer, theirReturn, yourPicks, nil, 0.10)
+	if len(you) != 2 || you[1].Name != "2026 Round 2" || len(them) != 1 {
+		t.Fatalf("expected the 2nd to close the gap, got %+v / %+v", you, them)
+	}
+
+	// Already within tolerance: nothing added
+	you, _ = balanceWithPicks(yourOffer, []ProposalPlayer{{DynastyValue: 4200}}, yourPicks, nil, 0.10)
+	if len(you) != 1 {
+		t.Fatalf("expected no pick for a balanced deal, got %+v", you)
+	}
+}
+
+func TestFAABAssetValue(t *testing.T) {
+	v := newPickValuer(nil, false, 12, 2026)
+	if v.assetValue(faabAsset(25)) != 0 {
+		t.Fatalf("expected FAAB to be worth nothing without a league budget")
+	}
+	v.faabBudget = 100
+	want := int(math.Round(float64(v.value(2026, FAAB_BUDGET_PICK_ROUND, 0)) * 0.25))
+	if got := v.assetValue("$25 FAAB"); got != want || got == 0 {
+		t.Fatalf("expected a quarter of the budget to be worth %d, got %d", want, got)
+	}
+	if !isPickOrFAABAsset("$25 FAAB") || !isPickOrFAABAsset("2027 Round 2 (from Team X)") || isPickOrFAABAsset("Justin Jefferson") {
+		t.Fatalf("unexpected non-player asset detection")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestFetchDynastyPickValuesCachesFailures(t *testing.T) {
+	requests := 0
+	savedClient := httpClient
+	httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusBadGateway, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	})}
+	defer func() { httpClient = savedClient }()
+
+	dynastyPickValuesCache.Lock()
+	savedData, savedTime := dynastyPickValuesCache.data, dynastyPickValuesCache.timestamp
+	dynastyPickValuesCache.data, dynastyPickValuesCache.timestamp = map[string]DynastyValue{}, time.Time{}
+	dynastyPickValuesCache.Unlock()
+	defer func() {
+		dynastyPickValuesCache.Lock()
+		dynastyPickValuesCache.data, dynastyPickValuesCache.timestamp = savedData, savedTime
+		dynastyPickValuesCache.Unlock()
+	}()
+
+	if values := fetchDynastyPickValues(); values != nil {
+		t.Fatalf("expected no values from a failed download, got %v", values)
+	}
+	fetchDynastyPickValues()
+	if requests != 1 {
+		t.Fatalf("expected the failure to be cached, got %d requests", requests)
+	}
+}
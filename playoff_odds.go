@@ -0,0 +1,367 @@
+// ABOUTME: Monte Carlo playoff odds simulator for Sleeper leagues
+// ABOUTME: Plays out the remaining schedule and bracket to estimate playoff, bye, and title odds
+
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	PLAYOFF_SIMULATIONS    = 2000
+	WEEKLY_SCORE_STDDEV    = 25.0  // Typical spread of a fantasy team's weekly score
+	DEFAULT_WEEKLY_SCORE   = 110.0 // Prior mean when no games have been played
+	SCORE_PRIOR_GAMES      = 3.0   // Games of prior weight blended into each team's scoring mean
+	DEFAULT_PLAYOFF_TEAMS  = 6
+	STRENGTH_PRIOR_SPREAD  = 0.2 // Strongest team's prior sits this fraction above the weakest
+	MIN_PLAYOFF_ODDS_SHOWN = 0.5 // Below this (percent) a team's odds show as <1%
+)
+
+// simTeam is one team's starting standings plus its expected weekly score
+type simTeam struct {
+	StandingsEntry
+	MeanScore float64
+}
+
+// scheduledGame is a remaining regular-season matchup between two rosters
+type scheduledGame struct {
+	Week int
+	Home int
+	Away int
+}
+
+// leaguePlayoffTeams returns the number of playoff teams from league settings
+func leaguePlayoffTeams(league map[string]interface{}) int {
+	if settings, ok := league["settings"].(map[string]interface{}); ok {
+		if n, ok := settings["playoff_teams"].(float64); ok && n > 0 {
+			return int(n)
+		}
+	}
+	return DEFAULT_PLAYOFF_TEAMS
+}
+
+// playoffByes returns how many top seeds skip the first round (e.g. 6 teams -> 2 byes)
+func playoffByes(playoffTeams int) int {
+	bracket := 1
+	for bracket < playoffTeams {
+		bracket *= 2
+	}
+	return bracket - playoffTeams
+}
+
+// buildRemainingSchedule pairs rosters by matchup_id for each remaining week
+func buildRemainingSchedule(weekly map[int][]map[string]interface{}) []scheduledGame {
+	weeks := make([]int, 0, len(weekly))
+	for w := range weekly {
+		weeks = append(weeks, w)
+	}
+	sort.Ints(weeks)
+
+	games := []scheduledGame{}
+	for _, w := range weeks {
+		byMatchup := make(map[float64][]int)
+		order := []float64{}
+		for _, m := range weekly[w] {
+			mid, ok := m["matchup_id"].(float64)
+			if !ok {
+				continue // No matchup this week (bye)
+			}
+			rid, _ := m["roster_id"].(float64)
+			if _, seen := byMatchup[mid]; !seen {
+				order = append(order, mid)
+			}
+			byMatchup[mid] = append(byMatchup[mid], int(rid))
+		}
+		for _, mid := range order {
+			if pair := byMatchup[mid]; len(pair) == 2 {
+				games = append(games, scheduledGame{Week: w, Home: pair[0], Away: pair[1]})
+			}
+		}
+	}
+	return games
+}
+
+// buildSimTeams converts standings into simulation teams.
+// Each team's scoring mean blends its points per game with a prior from team strength.
+func buildSimTeams(standings []StandingsEntry, strengths []TeamStrength, medianGames bool) []simTeam {
+	byRoster := teamStrengthByRoster(strengths)
+	teams := []simTeam{}
+	totalPoints, totalGames := 0.0, 0.0
+	for _, e := range standings {
+		games := float64(e.Wins + e.Losses + e.Ties)
+		if medianGames {
+			games /= 2 // Median games don't add points
+		}
+		totalPoints += e.PointsFor
+		totalGames += games
+		teams = append(teams, simTeam{StandingsEntry: e})
+	}
+
+	leagueAvg := DEFAULT_WEEKLY_SCORE
+	if totalGames > 0 {
+		leagueAvg = totalPoints / totalGames
+	}
+	for i := range teams {
+		prior := leagueAvg
+		if s, ok := byRoster[teams[i].RosterID]; ok && len(strengths) > 1 {
+			prior = leagueAvg * (1 - STRENGTH_PRIOR_SPREAD/2 + STRENGTH_PRIOR_SPREAD*s.Rating/100)
+		}
+		games := float64(teams[i].Wins + teams[i].Losses + teams[i].Ties)
+		if medianGames {
+			games /= 2
+		}
+		teams[i].MeanScore = (teams[i].PointsFor + prior*SCORE_PRIOR_GAMES) / (games + SCORE_PRIOR_GAMES)
+	}
+	return teams
+}
+
+// seedTeams orders simulated teams by playoff seed using the league standings tiebreakers
+func seedTeams(teams []simTeam) []simTeam {
+	byRoster := make(map[int]simTeam, len(teams))
+	entries := make([]StandingsEntry, len(teams))
+	for i, t := range teams {
+		byRoster[t.RosterID] = t
+		entries[i] = t.StandingsEntry
+	}
+	seeded := make([]simTeam, 0, len(teams))
+	for _, e := range playoffSeeding(entries) {
+		t := byRoster[e.RosterID]
+		t.StandingsEntry = e
+		seeded = append(seeded, t)
+	}
+	return seeded
+}
+
+// simulatePlayoffOdds plays out the remaining schedule and playoff bracket many times.
+// With median games, every team also plays the week's median score.
+func simulatePlayoffOdds(teams []simTeam, schedule []scheduledGame, playoffTeams int, medianGames bool, sims int, rng *rand.Rand) []PlayoffOdds {
+	if playoffTeams > len(teams) {
+		playoffTeams = len(teams)
+	}
+	byes := playoffByes(playoffTeams)
+	index := make(map[int]int, len(teams))
+	for i, t := range teams {
+		index[t.RosterID] = i
+	}
+
+	playoffs := make([]int, len(teams))
+	byeCounts := make([]int, len(teams))
+	titles := make([]int, len(teams))
+
+	season := newSeasonSim(teams, schedule, medianGames, rng)
+	for n := 0; n < sims; n++ {
+		seeds := seedTeams(season.play())[:playoffTeams]
+		for i, t := range seeds {
+			playoffs[index[t.RosterID]]++
+			if i < byes {
+				byeCounts[index[t.RosterID]]++
+			}
+		}
+		if finish := season.bracket(seeds, byes); len(finish) > 0 {
+			titles[index[finish[0].RosterID]]++
+		}
+	}
+
+	odds := make([]PlayoffOdds, len(teams))
+	for i, t := range teams {
+		odds[i] = PlayoffOdds{
+			RosterID:        t.RosterID,
+			TeamName:        t.TeamName,
+			Record:          t.Record(),
+			PlayoffPct:      pct(playoffs[i], sims),
+			ByePct:          pct(byeCounts[i], sims),
+			ChampionshipPct: pct(titles[i], sims),
+		}
+	}
+	sort.SliceStable(odds, func(i, j int) bool {
+		if odds[i].PlayoffPct != odds[j].PlayoffPct {
+			return odds[i].PlayoffPct > odds[j].PlayoffPct
+		}
+		return odds[i].ChampionshipPct > odds[j].ChampionshipPct
+	})
+	return odds
+}
+
+// seasonSim replays the rest of a regular season and its playoff bracket from fixed starting standings
+type seasonSim struct {
+	teams       []simTeam
+	index       map[int]int
+	weeks       []int
+	weekGames   map[int][]scheduledGame
+	medianGames bool
+	rng         *rand.Rand
+}
+
+func newSeasonSim(teams []simTeam, schedule []scheduledGame, medianGames bool, rng *rand.Rand) *seasonSim {
+	s := &seasonSim{teams: teams, index: make(map[int]int, len(teams)), weekGames: make(map[int][]scheduledGame), medianGames: medianGames, rng: rng}
+	for i, t := range teams {
+		s.index[t.RosterID] = i
+	}
+	// Group games by week so each team scores once per week
+	for _, g := range schedule {
+		if _, ok := s.weekGames[g.Week]; !ok {
+			s.weeks = append(s.weeks, g.Week)
+		}
+		s.weekGames[g.Week] = append(s.weekGames[g.Week], g)
+	}
+	return s
+}
+
+// sample draws one weekly score for a team
+func (s *seasonSim) sample(t simTeam) float64 {
+	return t.MeanScore + s.rng.NormFloat64()*WEEKLY_SCORE_STDDEV
+}
+
+// play simulates the remaining weeks and returns final records in the starting team order
+func (s *seasonSim) play() []simTeam {
+	season := append([]simTeam{}, s.teams...)
+	for _, w := range s.weeks {
+		scores := make(map[int]float64)
+		for _, g := range s.weekGames[w] {
+			hi, okH := s.index[g.Home]
+			ai, okA := s.index[g.Away]
+			if !okH || !okA {
+				continue
+			}
+			hs, as := s.sample(season[hi]), s.sample(season[ai])
+			scores[hi], scores[ai] = hs, as
+			season[hi].PointsFor += hs
+			season[ai].PointsFor += as
+			if hs >= as {
+				season[hi].Wins++
+				season[ai].Losses++
+			} else {
+				season[ai].Wins++
+				season[hi].Losses++
+			}
+		}
+		if s.medianGames && len(scores) > 1 {
+			all := make([]float64, 0, len(scores))
+			for _, sc := range scores {
+				all = append(all, sc)
+			}
+			sort.Float64s(all)
+			median := (all[(len(all)-1)/2] + all[len(all)/2]) / 2
+			for i, sc := range scores {
+				if sc > median {
+					season[i].Wins++
+				} else {
+					season[i].Losses++
+				}
+			}
+		}
+	}
+	return season
+}
+
+// bracket plays the playoff seeds out and returns them in finishing order, champion first.
+// Byes advance, then the best remaining seed plays the worst each round; teams knocked out in
+// the same round finish in seed order.
+func (s *seasonSim) bracket(seeds []simTeam, byes int) []simTeam {
+	if len(seeds) == 0 {
+		return nil
+	}
+	alive := append([]simTeam{}, seeds[:byes]...)
+	round := seeds[byes:]
+	eliminated := [][]simTeam{}
+	for len(round)+len(alive) > 1 {
+		winners := []simTeam{}
+		losers := []simTeam{}
+		for i, j := 0, len(round)-1; i < j; i, j = i+1, j-1 {
+			if s.sample(round[i]) >= s.sample(round[j]) {
+				winners = append(winners, round[i])
+				losers = append(losers, round[j])
+			} else {
+				winners = append(winners, round[j])
+				losers = append(losers, round[i])
+			}
+		}
+		if len(round)%2 == 1 {
+			winners = append(winners, round[len(round)/2])
+		}
+		eliminated = append(eliminated, losers)
+		round = append(alive, winners...)
+		alive = nil
+	}
+
+	seedOf := make(map[int]int, len(seeds))
+	for i, t := range seeds {
+		seedOf[t.RosterID] = i
+	}
+	finish := append([]simTeam{}, round...)
+	for r := len(eliminated) - 1; r >= 0; r-- {
+		out := eliminated[r]
+		sort.SliceStable(out, func(i, j int) bool { return seedOf[out[i].RosterID] < seedOf[out[j].RosterID] })
+		finish = append(finish, out...)
+	}
+	return finish
+}
+
+// calculateLeaguePlayoffOdds fetches the remaining regular season and simulates it.
+// Returns nil once the regular season is over.
+func calculateLeaguePlayoffOdds(league map[string]interface{}, leagueID string, week int, standings []StandingsEntry, strengths []TeamStrength, userRosterID int) []PlayoffOdds {
+	playoffStart := leaguePlayoffWeekStart(league)
+	if week < 1 || week >= playoffStart || len(standings) < 2 {
+		return nil
+	}
+	weeks := []int{}
+	for w := week; w < playoffStart; w++ {
+		weeks = append(weeks, w)
+	}
+	schedule := buildRemainingSchedule(fetchMatchupsForWeeks(leagueID, weeks))
+	medianGames := hasMedianGames(league)
+	teams := buildSimTeams(standings, strengths, medianGames)
+
+	// Seed from the league ID so odds are stable between page loads
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s:%d", leagueID, week)))
+	rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	odds := simulatePlayoffOdds(teams, schedule, leaguePlayoffTeams(league), medianGames, PLAYOFF_SIMULATIONS, rng)
+	for i := range odds {
+		odds[i].IsUserTeam = odds[i].RosterID == userRosterID
+	}
+	debugLog("[DEBUG] Simulated playoff odds for %d teams over %d remaining games", len(odds), len(schedule))
+	return odds
+}
+
+// userPlayoffOdds returns the user's row from a playoff odds table
+func userPlayoffOdds(odds []PlayoffOdds) (PlayoffOdds, bool) {
+	for _, o := range odds {
+		if o.IsUserTeam {
+			return o, true
+		}
+	}
+	return PlayoffOdds{}, false
+}
+
+// playoffStatusLabel summarizes playoff odds for the dashboard. The odds are simulated, so the
+// extremes read as >99% and <1% rather than clinched or eliminated.
+func playoffStatusLabel(o PlayoffOdds) string {
+	switch {
+	case o.PlayoffPct >= 100-MIN_PLAYOFF_ODDS_SHOWN:
+		return "Near Lock (>99%)"
+	case o.PlayoffPct < MIN_PLAYOFF_ODDS_SHOWN:
+		return "Long Shot (<1%)"
+	default:
+		return fmt.Sprintf("In Hunt (%.0f%%)", o.PlayoffPct)
+	}
+}
+
+func formatRecord(wins, losses, ties int) string {
+	if ties > 0 {
+		return fmt.Sprintf("%d-%d-%d", wins, losses, ties)
+	}
+	return fmt.Sprintf("%d-%d", wins, losses)
+}
+
+func pct(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*1000) / 10
+}
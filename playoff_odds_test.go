@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPlayoffByes(t *testing.T) {
+	cases := map[int]int{4: 0, 6: 2, 7: 1, 8: 0, 5: 3}
+	for teams, want := range cases {
+		if got := playoffByes(teams); got != want {
+			t.Fatalf("playoffByes(%d) = %d, want %d", teams, got, want)
+		}
+	}
+}
+
+func TestBuildRemainingSchedule(t *testing.T) {
+	weekly := map[int][]map[string]interface{}{
+		12: {
+			{"roster_id": float64(1), "matchup_id": float64(1)},
+			{"roster_id": float64(2), "matchup_id": float64(2)},
+			{"roster_id": float64(3), "matchup_id": float64(1)},
+			{"roster_id": float64(4), "matchup_id": float64(2)},
+			{"roster_id": float64(5), "matchup_id": nil},
+		},
+	}
+	games := buildRemainingSchedule(weekly)
+	if len(games) != 2 {
+		t.Fatalf("expected 2 games, got %+v", games)
+	}
+	if games[0].Home != 1 || games[0].Away != 3 {
+		t.Fatalf("unexpected pairing: %+v", games[0])
+	}
+}
+
+func TestSeedTeamsDivisionWinnersFirst(t *testing.T) {
+	teams := []simTeam{
+		{StandingsEntry: StandingsEntry{RosterID: 1, Wins: 10, Division: 1}},
+		{StandingsEntry: StandingsEntry{RosterID: 2, Wins: 9, Division: 1}},
+		{StandingsEntry: StandingsEntry{RosterID: 3, Wins: 6, Division: 2}},
+		{StandingsEntry: StandingsEntry{RosterID: 4, Wins: 5, Division: 2}},
+	}
+	seeds := seedTeams(teams)
+	if seeds[0].RosterID != 1 || seeds[1].RosterID != 3 || seeds[2].RosterID != 2 {
+		t.Fatalf("expected division winners seeded first, got %+v", seeds)
+	}
+}
+
+func TestSimulatePlayoffOdds(t *testing.T) {
+	teams := []simTeam{
+		{StandingsEntry: StandingsEntry{RosterID: 1, TeamName: "Clinched", Wins: 12}, MeanScore: 130},
+		{StandingsEntry: StandingsEntry{RosterID: 2, TeamName: "Bubble A", Wins: 6, Losses: 6}, MeanScore: 110},
+		{StandingsEntry: StandingsEntry{RosterID: 3, TeamName: "Bubble B", Wins: 6, Losses: 6}, MeanScore: 100},
+		{StandingsEntry: StandingsEntry{RosterID: 4, TeamName: "Out", Losses: 12}, MeanScore: 90},
+	}
+	schedule := []scheduledGame{{Week: 13, Home: 2, Away: 3}, {Week: 13, Home: 1, Away: 4}}
+	odds := simulatePlayoffOdds(teams, schedule, 2, false, 500, rand.New(rand.NewSource(1)))
+
+	byTeam := map[int]PlayoffOdds{}
+	total := 0.0
+	for _, o := range odds {
+		byTeam[o.RosterID] = o
+		total += o.ChampionshipPct
+	}
+	if byTeam[1].PlayoffPct != 100 || byTeam[4].PlayoffPct != 0 {
+		t.Fatalf("expected locked outcomes, got %+v", odds)
+	}
+	if byTeam[2].PlayoffPct <= byTeam[3].PlayoffPct {
+		t.Fatalf("stronger bubble team should have better odds: %+v", odds)
+	}
+	if total < 99.5 || total > 100.5 {
+		t.Fatalf("championship odds should sum to 100, got %.1f", total)
+	}
+	if odds[0].RosterID != 1 {
+		t.Fatalf("expected odds sorted by playoff pct, got %+v", odds)
+	}
+}
+
+func TestSimulatePlayoffOddsMedianGames(t *testing.T) {
+	teams := []simTeam{
+		{StandingsEntry: StandingsEntry{RosterID: 1, Wins: 4, Losses: 4}, MeanScore: 140},
+		{StandingsEntry: StandingsEntry{RosterID: 2, Wins: 5, Losses: 3}, MeanScore: 80},
+		{StandingsEntry: StandingsEntry{RosterID: 3, Wins: 5, Losses: 3}, MeanScore: 80},
+		{StandingsEntry: StandingsEntry{RosterID: 4, Wins: 5, Losses: 3}, MeanScore: 80},
+	}
+	schedule := []scheduledGame{{Week: 5, Home: 1, Away: 2}, {Week: 5, Home: 3, Away: 4}}
+	withMedian := simulatePlayoffOdds(teams, schedule, 1, true, 500, rand.New(rand.NewSource(2)))
+	without := simulatePlayoffOdds(teams, schedule, 1, false, 500, rand.New(rand.NewSource(2)))
+	find := func(odds []PlayoffOdds) float64 {
+		for _, o := range odds {
+			if o.RosterID == 1 {
+				return o.PlayoffPct
+			}
+		}
+		return -1
+	}
+	// A median win lets the high scorer catch the 5-win teams
+	if find(withMedian) <= find(without) {
+		t.Fatalf("median games should help the top scorer: %.1f vs %.1f", find(withMedian), find(without))
+	}
+}
+
+func TestPlayoffStatusLabel(t *testing.T) {
+	if got := playoffStatusLabel(PlayoffOdds{PlayoffPct: 99.6}); got != "Near Lock (>99%)" {
+		t.Fatalf("unexpected label %s", got)
+	}
+	if got := playoffStatusLabel(PlayoffOdds{PlayoffPct: 0.2}); got != "Long Shot (<1%)" {
+		t.Fatalf("unexpected label %s", got)
+	}
+	if got := playoffStatusLabel(PlayoffOdds{PlayoffPct: 61.7}); got != "In Hunt (62%)" {
+		t.Fatalf("unexpected label %s", got)
+	}
+}
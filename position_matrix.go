@@ -0,0 +1,246 @@
+// ABOUTME: League-wide team-by-position strength matrix for QB/RB/WR/TE
+// ABOUTME: Ranks each team's starter-quality depth and dynasty value per position and flags surplus and need
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// matrixPositions are the positions the matrix covers, in display order
+var matrixPositions = []string{"QB", "RB", "WR", "TE"}
+
+// slotDemand is how much of a starting slot each position fills across the league. Flex shares
+// follow typical usage: flex spots go mostly to RB and WR, superflex almost always to a QB.
+var slotDemand = map[string]map[string]float64{
+	"QB":         {"QB": 1},
+	"RB":         {"RB": 1},
+	"WR":         {"WR": 1},
+	"TE":         {"TE": 1},
+	"FLEX":       {"RB": 0.45, "WR": 0.45, "TE": 0.10},
+	"WRRB_FLEX":  {"RB": 0.5, "WR": 0.5},
+	"REC_FLEX":   {"WR": 0.75, "TE": 0.25},
+	"SUPER_FLEX": {"QB": 1},
+	"K":          {"K": 1},
+	"DEF":        {"DEF": 1},
+}
+
+// positionDemand returns the starters each team needs per position from the league's lineup
+func positionDemand(rosterPositions []string) map[string]float64 {
+	demand := map[string]float64{}
+	for _, slot := range starterSlots(rosterPositions) {
+		for pos, share := range slotDemand[slot] {
+			demand[pos] += share
+		}
+	}
+	return demand
+}
+
+// matrixPlayer is one rostered player considered for the matrix
+type matrixPlayer struct {
+	RosterID int
+	Name     string
+	Tier     int
+	Value    int
+	Active   bool // Not on IR or taxi
+}
+
+// matrixBetter orders players by tier (unranked last), then dynasty value
+func matrixBetter(a, b matrixPlayer) bool {
+	if (a.Tier > 0) != (b.Tier > 0) {
+		return a.Tier > 0
+	}
+	if a.Tier != b.Tier {
+		return a.Tier < b.Tier
+	}
+	if a.Value != b.Value {
+		return a.Value > b.Value
+	}
+	return a.Name < b.Name
+}
+
+// calculatePositionMatrix rates every roster at each position. A player counts as starter-quality
+// when he ranks inside the league's starter pool at his position (teams x starters needed).
+func calculatePositionMatrix(rosters []map[string]interface{}, players map[string]interface{}, tiers map[string][][]string, dynastyValues map[string]DynastyValue, isSuperFlex bool, rosterPositions []string, userNames map[string]string, userID string) PositionMatrix {
+	matrix := PositionMatrix{Positions: matrixPositions}
+	if len(rosters) == 0 {
+		return matrix
+	}
+	demand := positionDemand(rosterPositions)
+
+	byPos := map[string][]matrixPlayer{}
+	for _, r := range rosters {
+		rosterID, _ := r["roster_id"].(float64)
+		ownerID, _ := r["owner_id"].(string)
+		inactive := map[string]bool{}
+		for _, pid := range append(toStringSlice(r["reserve"]), toStringSlice(r["taxi"])...) {
+			inactive[pid] = true
+		}
+		matrix.Teams = append(matrix.Teams, TeamPositionRow{
+			RosterID:   int(rosterID),
+			TeamName:   rosterTeamName(r, userNames),
+			IsUserTeam: userID != "" && ownerID == userID,
+		})
+		for _, pid := range toStringSlice(r["players"]) {
+			p, ok := players[pid].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pos, _ := p["position"].(string)
+			if !isMatrixPosition(pos) {
+				continue
+			}
+			name := getPlayerName(p)
+			mp := matrixPlayer{RosterID: int(rosterID), Name: name, Tier: findTier(tiers[pos], name), Active: !inactive[pid]}
+			if dynastyValues != nil {
+				mp.Value = getDynastyValue(name, dynastyValues, isSuperFlex)
+			}
+			byPos[pos] = append(byPos[pos], mp)
+		}
+	}
+
+	teams := len(matrix.Teams)
+	rowIndex := make(map[int]int, teams)
+	for i, t := range matrix.Teams {
+		rowIndex[t.RosterID] = i
+		matrix.Teams[i].Positions = make([]PositionStrength, len(matrixPositions))
+	}
+
+	for pi, pos := range matrixPositions {
+		pool := byPos[pos]
+		sort.SliceStable(pool, func(i, j int) bool { return matrixBetter(pool[i], pool[j]) })
+		poolSize := int(math.Round(demand[pos] * float64(teams)))
+		needed := int(math.Round(demand[pos]))
+
+		for i := range matrix.Teams {
+			matrix.Teams[i].Positions[pi] = PositionStrength{Position: pos, Needed: needed}
+		}
+		inPool := 0
+		tierSum := map[int]int{}
+		tierCount := map[int]int{}
+		for _, mp := range pool {
+			ps := &matrix.Teams[rowIndex[mp.RosterID]].Positions[pi]
+			ps.Value += mp.Value
+			if ps.TopPlayer == "" && (mp.Tier > 0 || mp.Value > 0) {
+				ps.TopPlayer = mp.Name
+			}
+			if !mp.Active {
+				continue
+			}
+			// The team's best active players at the position set its starter tier
+			if mp.Tier > 0 && tierCount[mp.RosterID] < max(needed, 1) {
+				tierSum[mp.RosterID] += mp.Tier
+				tierCount[mp.RosterID]++
+			}
+			if inPool >= poolSize || (mp.Tier == 0 && mp.Value == 0) {
+				continue
+			}
+			inPool++
+			ps.StarterDepth++
+		}
+		for rosterID, count := range tierCount {
+			matrix.Teams[rowIndex[rosterID]].Positions[pi].StarterTier = float64(tierSum[rosterID]) / float64(count)
+		}
+		rankPosition(matrix.Teams, pi)
+	}
+
+	for i := range matrix.Teams {
+		for _, ps := range matrix.Teams[i].Positions {
+			switch ps.Status {
+			case "Surplus":
+				matrix.Teams[i].Surplus = append(matrix.Teams[i].Surplus, ps.Position)
+			case "Need":
+				matrix.Teams[i].Needs = append(matrix.Teams[i].Needs, ps.Position)
+			}
+		}
+	}
+	sort.SliceStable(matrix.Teams, func(i, j int) bool {
+		return matrix.Teams[i].TeamName < matrix.Teams[j].TeamName
+	})
+	return matrix
+}
+
+// isMatrixPosition reports whether pos is one of the matrix columns
+func isMatrixPosition(pos string) bool {
+	for _, p := range matrixPositions {
+		if p == pos {
+			return true
+		}
+	}
+	return false
+}
+
+// rankPosition scores one position column from starter depth, starter tier, and value, ranks the
+// league, and marks the top third with spare starters as surplus and the bottom third or short
+// teams as need. Positions the lineup doesn't start get no status.
+func rankPosition(teams []TeamPositionRow, pi int) {
+	n := len(teams)
+	depth := make([]float64, n)
+	tiers := make([]float64, n)
+	values := make([]float64, n)
+	for i, t := range teams {
+		ps := t.Positions[pi]
+		depth[i] = float64(ps.StarterDepth)
+		if ps.StarterTier > 0 {
+			tiers[i] = -ps.StarterTier // Lower tier is better
+		} else {
+			tiers[i] = math.Inf(-1)
+		}
+		values[i] = float64(ps.Value)
+	}
+	components := [][]float64{normalizeScores(depth), normalizeScores(tiers), normalizeScores(values)}
+	for i := range teams {
+		total, weights := 0.0, 0.0
+		for _, scores := range components {
+			if scores == nil {
+				continue // No spread across the league
+			}
+			total += scores[i]
+			weights++
+		}
+		teams[i].Positions[pi].Score = 50
+		if weights > 0 {
+			teams[i].Positions[pi].Score = math.Round(total/weights*1000) / 10
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := teams[order[a]].Positions[pi], teams[order[b]].Positions[pi]
+		if pa.Score != pb.Score {
+			return pa.Score > pb.Score
+		}
+		return pa.Value > pb.Value
+	})
+	third := n / 3
+	if third == 0 {
+		third = 1
+	}
+	for rank, i := range order {
+		ps := &teams[i].Positions[pi]
+		ps.Rank = rank + 1
+		if ps.Needed == 0 {
+			continue
+		}
+		switch {
+		case ps.StarterDepth > ps.Needed && ps.Rank <= third:
+			ps.Status = "Surplus"
+		case ps.StarterDepth < ps.Needed || (n > 2 && ps.Rank > n-third):
+			ps.Status = "Need"
+		}
+	}
+}
+
+// userPositionMatrixRow returns the user's row in the matrix
+func userPositionMatrixRow(matrix PositionMatrix) (TeamPositionRow, bool) {
+	for _, t := range matrix.Teams {
+		if t.IsUserTeam {
+			return t, true
+		}
+	}
+	return TeamPositionRow{}, false
+}
@@ -0,0 +1,63 @@
+package main
+
+import "testing"
+
+func TestPositionDemand(t *testing.T) {
+	demand := positionDemand([]string{"QB", "RB", "RB", "WR", "FLEX", "SUPER_FLEX", "BN", "IR"})
+	if demand["QB"] != 2 || demand["RB"] != 2.45 || demand["WR"] != 1.45 || demand["TE"] != 0.1 {
+		t.Fatalf("unexpected demand: %v", demand)
+	}
+}
+
+func TestCalculatePositionMatrix(t *testing.T) {
+	player := func(name, pos string) map[string]interface{} {
+		return map[string]interface{}{"first_name": name, "last_name": pos, "position": pos}
+	}
+	players := map[string]interface{}{
+		"a1": player("A1", "RB"), "a2": player("A2", "RB"), "a3": player("A3", "RB"), "aq": player("A", "QB"), "at": player("A", "TE"),
+		"bq": player("B", "QB"), "br": player("B", "RB"), "b1": player("B1", "WR"), "b2": player("B2", "WR"), "b3": player("B3", "WR"),
+		"cq": player("C", "QB"), "cr": player("C", "RB"), "ci": player("Hurt", "RB"), "cw": player("C", "WR"), "ct": player("C", "TE"),
+	}
+	tiers := map[string][][]string{
+		"QB": {{"B QB"}, {"A QB"}, {"C QB"}},
+		"RB": {{"A1 RB", "A2 RB", "A3 RB", "Hurt RB"}, {"C RB"}, {"B RB"}},
+		"WR": {{"B1 WR", "B2 WR", "B3 WR"}, {"C WR"}},
+		"TE": {{"A TE"}, {"C TE"}},
+	}
+	rosters := []map[string]interface{}{
+		{"roster_id": float64(1), "owner_id": "a", "players": []interface{}{"a1", "a2", "a3", "aq", "at"}},
+		{"roster_id": float64(2), "owner_id": "b", "players": []interface{}{"bq", "br", "b1", "b2", "b3"}},
+		{"roster_id": float64(3), "owner_id": "u1", "players": []interface{}{"cq", "cr", "ci", "cw", "ct"}, "reserve": []interface{}{"ci"}},
+	}
+	names := map[string]string{"a": "Alpha", "b": "Bravo", "u1": "Charlie"}
+	slots := []string{"QB", "RB", "RB", "WR", "WR", "TE", "FLEX", "BN"}
+
+	matrix := calculatePositionMatrix(rosters, players, tiers, nil, false, slots, names, "u1")
+	if len(matrix.Teams) != 3 || matrix.Teams[0].TeamName != "Alpha" {
+		t.Fatalf("expected teams sorted by name: %+v", matrix.Teams)
+	}
+	alpha, bravo := matrix.Teams[0], matrix.Teams[1]
+	if rb := alpha.Positions[1]; rb.StarterDepth != 3 || rb.Needed != 2 || rb.Rank != 1 || rb.Status != "Surplus" {
+		t.Fatalf("expected Alpha RB surplus: %+v", rb)
+	}
+	if len(bravo.Surplus) != 1 || bravo.Surplus[0] != "WR" {
+		t.Fatalf("expected Bravo WR surplus: %+v", bravo)
+	}
+
+	user, ok := userPositionMatrixRow(matrix)
+	if !ok || user.TeamName != "Charlie" {
+		t.Fatalf("expected user row, got %+v", user)
+	}
+	// The IR back doesn't count toward starter depth
+	if rb := user.Positions[1]; rb.StarterDepth != 1 || rb.Status != "Need" || rb.Rank != 2 {
+		t.Fatalf("unexpected user RB strength: %+v", rb)
+	}
+	if qb := user.Positions[0]; qb.Rank != 3 || qb.Status != "Need" {
+		t.Fatalf("expected user QB ranked last: %+v", qb)
+	}
+
+	card := positionMatrixCard(user, len(matrix.Teams))
+	if card.Value != "QB need (#3 of 3)" {
+		t.Fatalf("unexpected balance card: %+v", card)
+	}
+}
@@ -0,0 +1,304 @@
+// ABOUTME: Multi-factor power rankings blending lineup strength, value, picks, record, points, and trend
+// ABOUTME: Weights differ for redraft and dynasty leagues and can be overridden per league type via env
+
+package main
+
+import (
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const POWER_RANKING_TREND_WEEKS = 3 // Recent weeks compared against the season average
+
+// PowerRankingWeights sets how much each factor counts toward the power score
+type PowerRankingWeights struct {
+	Starters  float64 // Best-lineup tier strength this week
+	Value     float64 // Total dynasty value
+	Picks     float64 // Value of owned draft picks
+	Record    float64 // Win pct blended with all-play pct
+	PointsFor float64
+	Trend     float64 // Recent scoring vs season average
+}
+
+var redraftPowerRankingWeights = PowerRankingWeights{Starters: 0.30, Record: 0.25, PointsFor: 0.30, Trend: 0.15}
+
+var dynastyPowerRankingWeights = PowerRankingWeights{Starters: 0.20, Value: 0.25, Picks: 0.10, Record: 0.15, PointsFor: 0.20, Trend: 0.10}
+
+// powerRankingWeightsFor returns the league type's weights, applying any override from
+// POWER_RANKING_WEIGHTS_REDRAFT / POWER_RANKING_WEIGHTS_DYNASTY (e.g. "starters=0.4,trend=0.2")
+func powerRankingWeightsFor(isDynasty bool) PowerRankingWeights {
+	if isDynasty {
+		return parsePowerRankingWeights(os.Getenv("POWER_RANKING_WEIGHTS_DYNASTY"), dynastyPowerRankingWeights)
+	}
+	return parsePowerRankingWeights(os.Getenv("POWER_RANKING_WEIGHTS_REDRAFT"), redraftPowerRankingWeights)
+}
+
+// parsePowerRankingWeights overrides individual weights from a "factor=weight,..." list
+func parsePowerRankingWeights(spec string, defaults PowerRankingWeights) PowerRankingWeights {
+	w := defaults
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			log.Printf("[ERROR] Invalid power ranking weight %q", part)
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil || v < 0 {
+			log.Printf("[ERROR] Invalid power ranking weight %q", part)
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[0])) {
+		case "starters":
+			w.Starters = v
+		case "value":
+			w.Value = v
+		case "picks":
+			w.Picks = v
+		case "record":
+			w.Record = v
+		case "points", "points_for", "pf":
+			w.PointsFor = v
+		case "trend":
+			w.Trend = v
+		default:
+			log.Printf("[ERROR] Unknown power ranking factor %q", kv[0])
+		}
+	}
+	return w
+}
+
+// powerRankingInputs gathers the league-wide data the rankings blend
+type powerRankingInputs struct {
+	IsDynasty   bool
+	Standings   []StandingsEntry
+	Strengths   []TeamStrength
+	TeamAges    []TeamAgeData   // Dynasty only: average age feeds the contention window
+	PickCapital map[int]float64 // Dynasty only: roster ID -> value of picks owned
+	Weekly      map[int][]map[string]interface{}
+	Weights     PowerRankingWeights
+}
+
+// scoringTrend compares each roster's recent points per week with its season average (percent)
+func scoringTrend(weekly map[int][]map[string]interface{}) map[int]float64 {
+	weeks := []int{}
+	for w, matchups := range weekly {
+		scores := map[int]float64{}
+		for _, m := range matchups {
+			if rid, ok := m["roster_id"].(float64); ok {
+				scores[int(rid)] = matchupPoints(m)
+			}
+		}
+		if weekHasScores(scores) {
+			weeks = append(weeks, w)
+		}
+	}
+	trend := map[int]float64{}
+	if len(weeks) <= POWER_RANKING_TREND_WEEKS {
+		return trend // Not enough history for recent form to differ from the season
+	}
+	sort.Ints(weeks)
+	recentFrom := weeks[len(weeks)-POWER_RANKING_TREND_WEEKS]
+
+	season := map[int]float64{}
+	recent := map[int]float64{}
+	for _, w := range weeks {
+		for _, m := range weekly[w] {
+			rid, ok := m["roster_id"].(float64)
+			if !ok {
+				continue
+			}
+			season[int(rid)] += matchupPoints(m)
+			if w >= recentFrom {
+				recent[int(rid)] += matchupPoints(m)
+			}
+		}
+	}
+	for rid, total := range season {
+		avg := total / float64(len(weeks))
+		if avg <= 0 {
+			continue
+		}
+		recentAvg := recent[rid] / POWER_RANKING_TREND_WEEKS
+		trend[rid] = math.Round((recentAvg-avg)/avg*1000) / 10
+	}
+	return trend
+}
+
+// calculatePowerRankings scores every team on the weighted factors, classifies dynasty teams into
+// contention windows, and adds week-over-week movement by re-ranking the league as it stood
+// before the latest completed week
+func calculatePowerRankings(in powerRankingInputs) []PowerRanking {
+	rankings := scorePowerRankings(in)
+	if in.IsDynasty {
+		classifyContentionWindows(rankings)
+	}
+	if len(in.Weekly) == 0 {
+		return rankings
+	}
+
+	lastWeek := 0
+	for w := range in.Weekly {
+		if w > lastWeek {
+			lastWeek = w
+		}
+	}
+	prev := in
+	prev.Weekly = make(map[int][]map[string]interface{}, len(in.Weekly))
+	for w, matchups := range in.Weekly {
+		if w != lastWeek {
+			prev.Weekly[w] = matchups
+		}
+	}
+	prev.Standings = standingsBeforeWeek(in.Standings, in.Weekly[lastWeek])
+
+	prevRank := map[int]int{}
+	for _, r := range scorePowerRankings(prev) {
+		prevRank[r.RosterID] = r.Rank
+	}
+	for i := range rankings {
+		if p, ok := prevRank[rankings[i].RosterID]; ok {
+			rankings[i].PrevRank = p
+			rankings[i].Movement = p - rankings[i].Rank
+		}
+	}
+	return rankings
+}
+
+// standingsBeforeWeek removes one week's head-to-head results and points from the standings
+func standingsBeforeWeek(standings []StandingsEntry, matchups []map[string]interface{}) []StandingsEntry {
+	out := make([]StandingsEntry, len(standings))
+	copy(out, standings)
+	for i := range out {
+		_, yours, theirs, ok := matchupOpponent(matchups, out[i].RosterID)
+		if !ok || (yours == 0 && theirs == 0) {
+			continue
+		}
+		switch {
+		case yours > theirs && out[i].Wins > 0:
+			out[i].Wins--
+		case yours < theirs && out[i].Losses > 0:
+			out[i].Losses--
+		case yours == theirs && out[i].Ties > 0:
+			out[i].Ties--
+		}
+		out[i].PointsFor = math.Max(0, out[i].PointsFor-yours)
+	}
+	return out
+}
+
+// scorePowerRankings min-max normalizes each factor across the league and blends them by weight.
+// Factors with no spread (e.g. record before week 1) drop out and the rest are re-weighted.
+func scorePowerRankings(in powerRankingInputs) []PowerRanking {
+	if len(in.Standings) == 0 {
+		return nil
+	}
+	strengthByRoster := teamStrengthByRoster(in.Strengths)
+	allPlay := allPlayByRoster(calculateAllPlayRecords(in.Weekly, in.Standings))
+	trend := scoringTrend(in.Weekly)
+	ageByRoster := map[int]float64{}
+	for _, t := range in.TeamAges {
+		ageByRoster[t.RosterID] = t.AvgAge
+	}
+
+	n := len(in.Standings)
+	rankings := make([]PowerRanking, n)
+	starters := make([]float64, n)
+	values := make([]float64, n)
+	picks := make([]float64, n)
+	record := make([]float64, n)
+	points := make([]float64, n)
+	trends := make([]float64, n)
+	for i, e := range in.Standings {
+		s := strengthByRoster[e.RosterID]
+		r := PowerRanking{
+			TeamName:     e.TeamName,
+			RosterID:     e.RosterID,
+			RosterValue:  s.RosterValue,
+			StarterTier:  s.StarterTier,
+			PickCapital:  in.PickCapital[e.RosterID],
+			Wins:         e.Wins,
+			Losses:       e.Losses,
+			Ties:         e.Ties,
+			PointsFor:    e.PointsFor,
+			TrendPct:     trend[e.RosterID],
+			IsUserTeam:   e.IsUserTeam,
+			StandingRank: e.Rank,
+		}
+		if in.IsDynasty {
+			r.AvgAge = ageByRoster[e.RosterID]
+		}
+
+		if s.StarterTier > 0 {
+			starters[i] = -s.StarterTier // Lower tier is better
+		} else {
+			starters[i] = math.Inf(-1)
+		}
+		values[i] = float64(s.RosterValue)
+		picks[i] = r.PickCapital
+		record[i] = e.WinPct()
+		if ap, ok := allPlay[e.RosterID]; ok {
+			r.AllPlayRank = ap.Rank
+			r.AllPlayPct = ap.WinPct
+			r.Luck = ap.Luck
+			// All-play strips schedule luck out of the record
+			record[i] = (record[i] + ap.WinPct) / 2
+		}
+		points[i] = e.PointsFor
+		trends[i] = r.TrendPct
+		rankings[i] = r
+	}
+
+	w := in.Weights
+	components := []struct {
+		scores []float64
+		weight float64
+	}{
+		{normalizeScores(starters), w.Starters},
+		{normalizeScores(values), w.Value},
+		{normalizeScores(picks), w.Picks},
+		{normalizeScores(record), w.Record},
+		{normalizeScores(points), w.PointsFor},
+		{normalizeScores(trends), w.Trend},
+	}
+	for i := range rankings {
+		total, weights := 0.0, 0.0
+		for _, c := range components {
+			if c.scores == nil || c.weight == 0 {
+				continue
+			}
+			total += c.scores[i] * c.weight
+			weights += c.weight
+		}
+		rankings[i].Score = 50
+		if weights > 0 {
+			rankings[i].Score = math.Round(total/weights*1000) / 10
+		}
+	}
+
+	// Value rank (players plus picks) feeds the dynasty context card
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return float64(rankings[i].RosterValue)+rankings[i].PickCapital > float64(rankings[j].RosterValue)+rankings[j].PickCapital
+	})
+	for i := range rankings {
+		rankings[i].ValueRank = i + 1
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Score != rankings[j].Score {
+			return rankings[i].Score > rankings[j].Score
+		}
+		return rankings[i].StandingRank < rankings[j].StandingRank
+	})
+	for i := range rankings {
+		rankings[i].Rank = i + 1
+	}
+	return rankings
+}
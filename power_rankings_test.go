@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestPowerRankingsFavorContenderOverStashes(t *testing.T) {
+	in := powerRankingInputs{
+		IsDynasty: true,
+		Standings: []StandingsEntry{
+			{RosterID: 1, TeamName: "Contender", Rank: 1, Wins: 9, Losses: 2, PointsFor: 1500},
+			{RosterID: 2, TeamName: "Middle", Rank: 2, Wins: 6, Losses: 5, PointsFor: 1350},
+			{RosterID: 3, TeamName: "Stashes", Rank: 3, Wins: 2, Losses: 9, PointsFor: 1100},
+		},
+		Strengths: []TeamStrength{
+			{RosterID: 1, StarterTier: 3, RosterValue: 40000},
+			{RosterID: 2, StarterTier: 5, RosterValue: 45000},
+			{RosterID: 3, StarterTier: 8, RosterValue: 60000},
+		},
+		TeamAges:    []TeamAgeData{{RosterID: 1, AvgAge: 28}, {RosterID: 3, AvgAge: 23}},
+		PickCapital: map[int]float64{3: 4},
+		Weights:     dynastyPowerRankingWeights,
+	}
+	rankings := calculatePowerRankings(in)
+	if rankings[0].TeamName != "Contender" || rankings[2].TeamName != "Stashes" {
+		t.Fatalf("expected contender first and stash team last: %+v", rankings)
+	}
+	if rankings[0].Window != WindowContender || rankings[2].Window != WindowRebuilding {
+		t.Fatalf("unexpected windows: %s / %s", rankings[0].Window, rankings[2].Window)
+	}
+	if rankings[2].ValueRank != 1 || rankings[2].PickCapital != 4 {
+		t.Fatalf("expected stash team to keep top value rank and picks: %+v", rankings[2])
+	}
+
+	// Value-only weights flip the order
+	in.Weights = PowerRankingWeights{Value: 1}
+	if top := calculatePowerRankings(in)[0]; top.TeamName != "Stashes" {
+		t.Fatalf("expected value-only ranking to favor stashes, got %s", top.TeamName)
+	}
+}
+
+func TestPowerRankingMovement(t *testing.T) {
+	standings := []StandingsEntry{
+		{RosterID: 1, TeamName: "Riser", Rank: 1, Wins: 1, Losses: 1, PointsFor: 260},
+		{RosterID: 2, TeamName: "Faller", Rank: 2, Wins: 1, Losses: 1, PointsFor: 230},
+	}
+	weekly := map[int][]map[string]interface{}{
+		1: {matchupEntry(1, 1, 100), matchupEntry(2, 1, 110)},
+		2: {matchupEntry(1, 1, 160), matchupEntry(2, 1, 120)},
+	}
+	rankings := calculatePowerRankings(powerRankingInputs{Standings: standings, Weekly: weekly, Weights: redraftPowerRankingWeights})
+	if rankings[0].TeamName != "Riser" || rankings[0].Movement != 1 || rankings[0].PrevRank != 2 {
+		t.Fatalf("expected riser to move up one spot: %+v", rankings)
+	}
+	if rankings[1].Movement != -1 {
+		t.Fatalf("expected faller to drop one spot: %+v", rankings[1])
+	}
+}
+
+func TestStandingsBeforeWeek(t *testing.T) {
+	standings := []StandingsEntry{{RosterID: 1, Wins: 3, PointsFor: 400}, {RosterID: 2, Losses: 3, PointsFor: 300}}
+	prev := standingsBeforeWeek(standings, []map[string]interface{}{matchupEntry(1, 1, 130), matchupEntry(2, 1, 100)})
+	if prev[0].Wins != 2 || prev[0].PointsFor != 270 || prev[1].Losses != 2 || prev[1].PointsFor != 200 {
+		t.Fatalf("unexpected prior standings: %+v", prev)
+	}
+	if standings[0].Wins != 3 {
+		t.Fatalf("expected input standings untouched")
+	}
+}
+
+func TestScoringTrend(t *testing.T) {
+	weekly := map[int][]map[string]interface{}{}
+	for w, pts := range []float64{100, 100, 100, 130, 130, 130} {
+		weekly[w+1] = []map[string]interface{}{matchupEntry(1, 1, pts), matchupEntry(2, 1, 100)}
+	}
+	trend := scoringTrend(weekly)
+	if trend[1] != 13.0 || trend[2] != 0 {
+		t.Fatalf("unexpected trend: %v", trend)
+	}
+	if len(scoringTrend(map[int][]map[string]interface{}{1: weekly[1]})) != 0 {
+		t.Fatalf("expected no trend with one week of history")
+	}
+}
+
+func TestPowerRankingWeights(t *testing.T) {
+	w := parsePowerRankingWeights("starters=0.5, trend=0, bogus=1, value=abc", redraftPowerRankingWeights)
+	if w.Starters != 0.5 || w.Trend != 0 || w.Record != redraftPowerRankingWeights.Record {
+		t.Fatalf("unexpected weights: %+v", w)
+	}
+	t.Setenv("POWER_RANKING_WEIGHTS_DYNASTY", "picks=0.4")
+	if got := powerRankingWeightsFor(true); got.Picks != 0.4 || got.Value != dynastyPowerRankingWeights.Value {
+		t.Fatalf("expected env override, got %+v", got)
+	}
+}
@@ -14,11 +14,22 @@ type LeagueProvider interface {
 	FetchLeagueMatchups(leagueID string, week int) ([]map[string]interface{}, error)
 	FetchLeagueUsers(leagueID string) ([]map[string]interface{}, error)
 	FetchLeagueTradedPicks(leagueID string) ([]map[string]interface{}, error)
+	FetchLeague(leagueID string) (map[string]interface{}, error)
+	FetchLeagueTransactions(leagueID string, week int) ([]map[string]interface{}, error)
+	FetchTrendingPlayers(kind string, lookbackHours, limit int) ([]map[string]interface{}, error)
+	FetchWeekStats(season string, week int) (map[string]interface{}, error)
+	FetchLeagueDrafts(leagueID string) ([]map[string]interface{}, error)
+	FetchDraft(draftID string) (map[string]interface{}, error)
+	FetchDraftPicks(draftID string) ([]map[string]interface{}, error)
+	FetchDraftTradedPicks(draftID string) ([]map[string]interface{}, error)
+	FetchNFLSchedule(season string) ([]map[string]interface{}, error)
+	FetchLeagueBracket(leagueID, side string) ([]map[string]interface{}, error)
 }
 
 type SleeperProvider struct {
-	baseURL string
-	client  *http.Client
+	baseURL     string
+	scheduleURL string // The NFL schedule lives on Sleeper's unversioned API host
+	client      *http.Client
 }
 
 func NewSleeperProvider(client *http.Client) *SleeperProvider {
@@ -26,8 +37,9 @@ func NewSleeperProvider(client *http.Client) *SleeperProvider {
 		client = http.DefaultClient
 	}
 	return &SleeperProvider{
-		baseURL: "https://api.sleeper.app/v1",
-		client:  client,
+		baseURL:     "https://api.sleeper.app/v1",
+		scheduleURL: "https://api.sleeper.com",
+		client:      client,
 	}
 }
 
@@ -61,6 +73,54 @@ func (p *SleeperProvider) FetchLeagueTradedPicks(leagueID string) ([]map[string]
 	return p.fetchJSONArray(fmt.Sprintf("%s/league/%s/traded_picks", p.baseURL, leagueID))
 }
 
+func (p *SleeperProvider) FetchLeague(leagueID string) (map[string]interface{}, error) {
+	return p.fetchJSON(fmt.Sprintf("%s/league/%s", p.baseURL, leagueID))
+}
+
+func (p *SleeperProvider) FetchLeagueTransactions(leagueID string, week int) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/league/%s/transactions/%d", p.baseURL, leagueID, week))
+}
+
+// FetchTrendingPlayers returns Sleeper-wide trending "add" or "drop" counts
+func (p *SleeperProvider) FetchTrendingPlayers(kind string, lookbackHours, limit int) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/players/nfl/trending/%s?lookback_hours=%d&limit=%d", p.baseURL, kind, lookbackHours, limit))
+}
+
+// FetchWeekStats returns player_id -> stat line (including pts_ppr, pts_half_ppr, pts_std) for a regular-season week
+func (p *SleeperProvider) FetchWeekStats(season string, week int) (map[string]interface{}, error) {
+	return p.fetchJSON(fmt.Sprintf("%s/stats/nfl/regular/%s/%d", p.baseURL, season, week))
+}
+
+// FetchLeagueDrafts lists the league's drafts (startup or rookie), newest first
+func (p *SleeperProvider) FetchLeagueDrafts(leagueID string) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/league/%s/drafts", p.baseURL, leagueID))
+}
+
+// FetchDraft returns a draft's status, settings, and draft order
+func (p *SleeperProvider) FetchDraft(draftID string) (map[string]interface{}, error) {
+	return p.fetchJSON(fmt.Sprintf("%s/draft/%s", p.baseURL, draftID))
+}
+
+// FetchDraftPicks returns every pick made so far in a draft
+func (p *SleeperProvider) FetchDraftPicks(draftID string) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/draft/%s/picks", p.baseURL, draftID))
+}
+
+// FetchDraftTradedPicks returns the draft's traded picks (round, original roster_id, current owner_id)
+func (p *SleeperProvider) FetchDraftTradedPicks(draftID string) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/draft/%s/traded_picks", p.baseURL, draftID))
+}
+
+// FetchNFLSchedule returns every regular-season game for a season (week, home, away)
+func (p *SleeperProvider) FetchNFLSchedule(season string) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/schedule/nfl/regular/%s", p.scheduleURL, season))
+}
+
+// FetchLeagueBracket returns the league's "winners" or "losers" playoff bracket matches
+func (p *SleeperProvider) FetchLeagueBracket(leagueID, side string) ([]map[string]interface{}, error) {
+	return p.fetchJSONArray(fmt.Sprintf("%s/league/%s/%s_bracket", p.baseURL, leagueID, side))
+}
+
 func (p *SleeperProvider) fetchJSON(url string) (map[string]interface{}, error) {
 	resp, err := p.client.Get(url)
 	if err != nil {
@@ -50,6 +50,13 @@ func TestSleeperProviderFetchLeagueEndpoints(t *testing.T) {
 	_, _ = p.FetchLeagueMatchups("l1", 2)
 	_, _ = p.FetchLeagueUsers("l1")
 	_, _ = p.FetchLeagueTradedPicks("l1")
+	_, _ = p.FetchLeague("l1")
+	_, _ = p.FetchLeagueTransactions("l1", 3)
+	_, _ = p.FetchTrendingPlayers("add", 24, 25)
+	_, _ = p.FetchWeekStats("2025", 4)
+	_, _ = p.FetchLeagueDrafts("l1")
+	_, _ = p.FetchDraft("d1")
+	_, _ = p.FetchDraftPicks("d1")
 
 	expected := []string{
 		"/user/u1/leagues/nfl/2026",
@@ -58,6 +65,13 @@ func TestSleeperProviderFetchLeagueEndpoints(t *testing.T) {
 		"/league/l1/matchups/2",
 		"/league/l1/users",
 		"/league/l1/traded_picks",
+		"/league/l1",
+		"/league/l1/transactions/3",
+		"/players/nfl/trending/add",
+		"/stats/nfl/regular/2025/4",
+		"/league/l1/drafts",
+		"/draft/d1",
+		"/draft/d1/picks",
 	}
 	if len(seen) != len(expected) {
 		t.Fatalf("expected %d requests, got %d (%v)", len(expected), len(seen), seen)
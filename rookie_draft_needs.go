@@ -185,7 +185,7 @@ func analyzePositionNeed(position string, youngCount, totalCount int, league Lea
 	picksAvailable := countPicksInRange(league.DraftPicks, draftRange)
 
 	// Add examples
-	examples := getPositionExamples(position, archetype)
+	examples := getPositionExamples(position, archetype, league.TopRookies)
 
 	need.Priority = priority
 	need.Reasoning = reasoning
@@ -275,8 +275,21 @@ func countPicksInRange(picks []DraftPick, draftRange string) int {
 	return count
 }
 
-func getPositionExamples(position, archetype string) []string {
-	// Return example player types/archetypes
+func getPositionExamples(position, archetype string, prospects []RookieProspect) []string {
+	// Prefer the league's upcoming class; fall back to established examples of the archetype
+	fromClass := []string{}
+	for _, p := range prospects {
+		if p.Position == position {
+			fromClass = append(fromClass, p.Name)
+			if len(fromClass) == 3 {
+				break
+			}
+		}
+	}
+	if len(fromClass) > 0 {
+		return fromClass
+	}
+
 	examples := map[string][]string{
 		"RB": {"Bijan Robinson", "Jahmyr Gibbs", "Blake Corum"},
 		"WR": {"Marvin Harrison Jr", "Rome Odunze", "Malik Nabers"},
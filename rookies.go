@@ -0,0 +1,172 @@
+// ABOUTME: Rookie prospect rankings for a league's upcoming draft class
+// ABOUTME: Prefers DynastyProcess values by draft year, falls back to the versioned prospect file, and links Sleeper IDs
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultRookieProspectsFile = "data/rookie_prospects.json"
+	ROOKIE_PROSPECT_LIMIT      = 25
+	minDynastyRookieClass      = 10 // Fewer DynastyProcess rookies than this means the class isn't priced yet
+)
+
+// rookieProspectFile is the versioned fallback list, edited by hand each spring
+type rookieProspectFile struct {
+	Version   string           `json:"version"`
+	Updated   string           `json:"updated"`
+	Prospects []RookieProspect `json:"prospects"`
+}
+
+// rookieProspectsPath returns the prospect file, overridable with ROOKIE_PROSPECTS_FILE
+func rookieProspectsPath() string {
+	if path := strings.TrimSpace(os.Getenv("ROOKIE_PROSPECTS_FILE")); path != "" {
+		return path
+	}
+	return defaultRookieProspectsFile
+}
+
+// loadRookieProspectFile reads the file on every call so edits show up without a restart
+func loadRookieProspectFile(path string) (rookieProspectFile, error) {
+	var file rookieProspectFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+	err = json.Unmarshal(data, &file)
+	return file, err
+}
+
+// upcomingDraftYear is the rookie class the league drafts next. Sleeper seasons roll over
+// before the rookie draft, so a pre-draft league drafts its own season's class.
+func upcomingDraftYear(league map[string]interface{}) int {
+	seasonStr, _ := league["season"].(string)
+	season, _ := strconv.Atoi(seasonStr)
+	if season == 0 {
+		return 0
+	}
+	status, _ := league["status"].(string)
+	switch status {
+	case "pre_draft", "drafting":
+		return season
+	}
+	return season + 1
+}
+
+// rookiesFromDynastyValues ranks the draft year's fantasy-relevant players by dynasty value
+func rookiesFromDynastyValues(values map[string]DynastyValue, draftYear int, isSuperFlex bool) []RookieProspect {
+	rookies := []RookieProspect{}
+	for _, dv := range values {
+		if dv.DraftYear != draftYear {
+			continue
+		}
+		switch dv.Position {
+		case "QB", "RB", "WR", "TE":
+		default:
+			continue // Rookie drafts skip kickers and defenses
+		}
+		value := dv.Value1QB
+		if isSuperFlex {
+			value = dv.Value2QB
+		}
+		if value <= 0 {
+			continue
+		}
+		team := dv.Team
+		if team == "FA" {
+			team = ""
+		}
+		rookies = append(rookies, RookieProspect{
+			Name:     dv.Name,
+			Position: dv.Position,
+			Team:     team,
+			Value:    value,
+			Year:     draftYear,
+			Source:   "DynastyProcess",
+		})
+	}
+	return rookies
+}
+
+// getTopRookies returns the ranked class for draftYear. DynastyProcess values win once the class
+// is priced there; otherwise the prospect file fills in. File entries also supply colleges.
+func getTopRookies(values map[string]DynastyValue, players map[string]interface{}, draftYear int, isSuperFlex bool) []RookieProspect {
+	file, err := loadRookieProspectFile(rookieProspectsPath())
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("[ERROR] Failed to load rookie prospects: %v", err)
+		totalErrors.Inc()
+	}
+	fromFile := map[string]RookieProspect{}
+	fileClass := []RookieProspect{}
+	for _, p := range file.Prospects {
+		if p.Year != draftYear || p.Value <= 0 {
+			continue
+		}
+		p.Source = "prospect file"
+		fromFile[normalizeName(p.Name)] = p
+		fileClass = append(fileClass, p)
+	}
+
+	rookies := rookiesFromDynastyValues(values, draftYear, isSuperFlex)
+	if len(rookies) >= minDynastyRookieClass {
+		for i := range rookies {
+			if p, ok := fromFile[normalizeName(rookies[i].Name)]; ok {
+				rookies[i].College = p.College
+			}
+		}
+	} else {
+		rookies = fileClass
+	}
+
+	sort.SliceStable(rookies, func(i, j int) bool {
+		if rookies[i].Value != rookies[j].Value {
+			return rookies[i].Value > rookies[j].Value
+		}
+		return rookies[i].Name < rookies[j].Name
+	})
+	if len(rookies) > ROOKIE_PROSPECT_LIMIT {
+		rookies = rookies[:ROOKIE_PROSPECT_LIMIT]
+	}
+	for i := range rookies {
+		rookies[i].Rank = i + 1
+	}
+	linkRookiesToSleeper(rookies, players)
+	debugLog("[DEBUG] Loaded %d %d rookie prospects (prospect file %s)", len(rookies), draftYear, file.Version)
+	return rookies
+}
+
+// linkRookiesToSleeper attaches Sleeper player IDs (and NFL teams) by name and position
+func linkRookiesToSleeper(rookies []RookieProspect, players map[string]interface{}) {
+	if len(rookies) == 0 || len(players) == 0 {
+		return
+	}
+	index := make(map[string]int, len(rookies))
+	for i, r := range rookies {
+		index[normalizeName(r.Name)+"|"+r.Position] = i
+	}
+	for pid, raw := range players {
+		p, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if exp, ok := p["years_exp"].(float64); ok && exp > 1 {
+			continue // Veteran sharing a rookie's name
+		}
+		pos, _ := p["position"].(string)
+		i, ok := index[normalizeName(getPlayerName(p))+"|"+pos]
+		if !ok {
+			continue
+		}
+		rookies[i].PlayerID = pid
+		if team, _ := p["team"].(string); team != "" {
+			rookies[i].Team = team
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeProspectFile(t *testing.T, body string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "prospects.json")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatalf("write prospects: %v", err)
+	}
+	t.Setenv("ROOKIE_PROSPECTS_FILE", path)
+}
+
+func TestUpcomingDraftYear(t *testing.T) {
+	cases := []struct {
+		status string
+		want   int
+	}{
+		{"pre_draft", 2026},
+		{"drafting", 2026},
+		{"in_season", 2027},
+		{"complete", 2027},
+	}
+	for _, c := range cases {
+		if got := upcomingDraftYear(map[string]interface{}{"season": "2026", "status": c.status}); got != c.want {
+			t.Fatalf("status %s: expected %d, got %d", c.status, c.want, got)
+		}
+	}
+	if got := upcomingDraftYear(map[string]interface{}{}); got != 0 {
+		t.Fatalf("expected 0 without a season, got %d", got)
+	}
+}
+
+func TestGetTopRookiesFallsBackToProspectFile(t *testing.T) {
+	writeProspectFile(t, `{"version":"test","prospects":[
+		{"name":"Future Back","position":"RB","college":"State","year":2027,"rank":2,"value":5000},
+		{"name":"Future Passer","position":"QB","college":"Tech","year":2027,"rank":1,"value":6000},
+		{"name":"Old Class","position":"WR","year":2026,"rank":1,"value":9000}
+	]}`)
+	// Only one DynastyProcess rookie for 2027: not enough to replace the file
+	values := map[string]DynastyValue{
+		"lone rookie": {Name: "Lone Rookie", Position: "WR", DraftYear: 2027, Value1QB: 7000},
+	}
+	rookies := getTopRookies(values, nil, 2027, false)
+	if len(rookies) != 2 || rookies[0].Name != "Future Passer" || rookies[0].Rank != 1 || rookies[1].Source != "prospect file" {
+		t.Fatalf("unexpected fallback class: %+v", rookies)
+	}
+}
+
+func TestGetTopRookiesPrefersDynastyValues(t *testing.T) {
+	writeProspectFile(t, `{"prospects":[{"name":"Rookie 3","position":"WR","college":"Ohio State","year":2026,"rank":1,"value":1}]}`)
+	values := map[string]DynastyValue{
+		"veteran": {Name: "Veteran", Position: "WR", DraftYear: 2020, Value1QB: 9000},
+		"kicker":  {Name: "Kicker", Position: "K", DraftYear: 2026, Value1QB: 100},
+	}
+	for i := 0; i < minDynastyRookieClass; i++ {
+		name := fmt.Sprintf("Rookie %d", i)
+		values[normalizeName(name)] = DynastyValue{Name: name, Position: "WR", Team: "FA", DraftYear: 2026, Value1QB: 1000 + i, Value2QB: 2000 - i}
+	}
+	players := map[string]interface{}{
+		"9001": map[string]interface{}{"first_name": "Rookie", "last_name": "3", "position": "WR", "team": "CHI", "years_exp": float64(0)},
+		"42":   map[string]interface{}{"first_name": "Rookie", "last_name": "4", "position": "WR", "team": "NYJ", "years_exp": float64(8)},
+	}
+
+	rookies := getTopRookies(values, players, 2026, false)
+	if len(rookies) != minDynastyRookieClass || rookies[0].Name != fmt.Sprintf("Rookie %d", minDynastyRookieClass-1) {
+		t.Fatalf("expected DynastyProcess class ranked by value: %+v", rookies)
+	}
+	var r3, r4 RookieProspect
+	for _, r := range rookies {
+		switch r.Name {
+		case "Rookie 3":
+			r3 = r
+		case "Rookie 4":
+			r4 = r
+		case "Veteran", "Kicker":
+			t.Fatalf("unexpected non-rookie %s", r.Name)
+		}
+	}
+	if r3.PlayerID != "9001" || r3.Team != "CHI" || r3.College != "Ohio State" {
+		t.Fatalf("expected Rookie 3 linked to Sleeper with file college: %+v", r3)
+	}
+	if r4.PlayerID != "" || r4.Team != "" {
+		t.Fatalf("expected veteran namesake not to link and FA to be blank: %+v", r4)
+	}
+
+	sf := getTopRookies(values, nil, 2026, true)
+	if sf[0].Name != "Rookie 0" {
+		t.Fatalf("expected superflex values to reorder the class, got %s", sf[0].Name)
+	}
+}
+
+func TestRookieDraftExamplesUseClass(t *testing.T) {
+	prospects := []RookieProspect{{Name: "A", Position: "WR"}, {Name: "B", Position: "RB"}, {Name: "C", Position: "WR"}}
+	if got := getPositionExamples("WR", "", prospects); len(got) != 2 || got[0] != "A" || got[1] != "C" {
+		t.Fatalf("unexpected class examples: %v", got)
+	}
+	if got := getPositionExamples("TE", "", prospects); len(got) == 0 {
+		t.Fatalf("expected archetype fallback for a position missing from the class")
+	}
+}
@@ -50,10 +50,12 @@ func buildRowsWithPositions(ids []string, players map[string]interface{}, tiers
 
 		// IR indicator will be added to displayName
 		displayName := name
+		isIR := false
 		// IR indicator: if player is in irList
 		for _, irid := range irList {
 			if irid == pid {
 				displayName += ` <span style="color:#ff7b7b;font-size:0.95em;">(IR)</span>`
+				isIR = true
 				break
 			}
 		}
@@ -77,12 +79,19 @@ func buildRowsWithPositions(ids []string, players map[string]interface{}, tiers
 		if ageVal, ok := p["age"].(float64); ok {
 			age = int(ageVal)
 		}
+		injuryStatus, _ := p["injury_status"].(string)
+		realPos, _ := p["position"].(string)
+		team, _ := p["team"].(string)
+		yearsExp := 0
+		if exp, ok := p["years_exp"].(float64); ok {
+			yearsExp = int(exp)
+		}
 
 		if tier > 0 {
-			rows = append(rows, PlayerRow{Pos: pos, Name: displayName, Tier: tier, IsTierWorseThanBench: isWorse, ShouldSwapIn: shouldSwapIn, Age: age})
+			rows = append(rows, PlayerRow{PlayerID: pid, Pos: pos, Name: displayName, Tier: tier, IsTierWorseThanBench: isWorse, ShouldSwapIn: shouldSwapIn, Age: age, YearsExp: yearsExp, InjuryStatus: injuryStatus, RealPos: realPos, Team: team, IsIR: isIR})
 			tierNums = append(tierNums, tier)
 		} else {
-			unranked = append(unranked, PlayerRow{Pos: "?", Name: displayName, Tier: "Not Ranked", IsTierWorseThanBench: false, ShouldSwapIn: false, Age: age})
+			unranked = append(unranked, PlayerRow{PlayerID: pid, Pos: "?", Name: displayName, Tier: "Not Ranked", IsTierWorseThanBench: false, ShouldSwapIn: false, Age: age, YearsExp: yearsExp, InjuryStatus: injuryStatus, RealPos: realPos, Team: team, IsIR: isIR})
 		}
 	}
 	return rows, unranked, tierNums
@@ -158,3 +167,35 @@ func winProbability(avg, opp string) (string, string) {
 
 	return fmt.Sprintf("%d%% %s", int(prob), winner), emoji
 }
+
+// rosterTeamName returns the custom team name for a roster, falling back to the owner's display name
+func rosterTeamName(r map[string]interface{}, userNames map[string]string) string {
+	teamName := "Unknown"
+	ownerID, _ := r["owner_id"].(string)
+	if name, exists := userNames[ownerID]; exists && name != "" {
+		teamName = name
+	}
+	if metadata, ok := r["metadata"].(map[string]interface{}); ok {
+		if tn, ok := metadata["team_name"].(string); ok && tn != "" {
+			teamName = tn
+		}
+	}
+	return teamName
+}
+
+// leagueUserNames maps user_id to display name (falling back to username)
+func leagueUserNames(users []map[string]interface{}) map[string]string {
+	names := map[string]string{}
+	for _, u := range users {
+		uid, ok := u["user_id"].(string)
+		if !ok {
+			continue
+		}
+		if dn, ok := u["display_name"].(string); ok && dn != "" {
+			names[uid] = dn
+		} else if un, ok := u["username"].(string); ok {
+			names[uid] = un
+		}
+	}
+	return names
+}
@@ -5,6 +5,7 @@ package main
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +37,10 @@ func generateSeasonPlan(league LeagueData, isPremium bool) SeasonPlan {
 
 	// Calculate weeks remaining
 	plan.WeeksRemaining = calculateWeeksRemaining(now, league.HasMatchups)
+	if len(league.ScheduleOutlook) > 0 {
+		plan.WeeksRemaining = len(league.ScheduleOutlook)
+	}
+	plan.WeeklyOutlook = league.ScheduleOutlook
 
 	// Determine trade window status
 	plan.TradeWindow = determineTradeWindow(now)
@@ -72,35 +77,27 @@ func determineSeasonPhase(now time.Time) (string, string) {
 }
 
 func determineStrategy(league LeagueData) string {
-	// For dynasty leagues, use power ranking and age
+	// For dynasty leagues, follow the user's contention window
 	if league.IsDynasty {
-		userRank := 0
 		for _, pr := range league.PowerRankings {
-			if pr.IsUserTeam {
-				userRank = pr.ValueRank
-				avgAge := pr.AvgAge
-
-				// Competing: top 4 value, any age
-				if userRank <= 4 {
-					if avgAge > 27.5 {
-						return "Win Now"
-					}
-					return "Contending"
-				}
-
-				// Rebuilding: bottom half value
-				if userRank > 6 {
-					if avgAge < 25.5 {
-						return "Rebuild"
-					}
-					return "Retool"
+			if !pr.IsUserTeam {
+				continue
+			}
+			switch pr.Window {
+			case WindowContender:
+				if pr.AvgAge > 27.5 {
+					return "Win Now"
 				}
-
-				// Middle: depends on age
-				if avgAge < 26.0 {
+				return "Contending"
+			case WindowFringe:
+				if pr.AvgAge > 0 && pr.AvgAge < 26.0 {
 					return "Build"
 				}
 				return "Retool"
+			case WindowRetooling:
+				return "Retool"
+			case WindowRebuilding:
+				return "Rebuild"
 			}
 		}
 	}
@@ -311,6 +308,11 @@ func buildScheduleDifficultyProfile(league LeagueData, strategy string) (string,
 		return "Offseason", "No active weekly matchups; focus on roster construction and value accumulation."
 	}
 
+	// Prefer the real remaining schedule when it's available
+	if len(league.ScheduleOutlook) > 0 {
+		return summarizeScheduleOutlook(league.ScheduleOutlook)
+	}
+
 	score := 1 // baseline: moderate
 	winProb := parseWinProbPct(league.WinProb)
 	if winProb > 0 {
@@ -349,6 +351,87 @@ func buildScheduleDifficultyProfile(league LeagueData, strategy string) (string,
 	return "Hard", "Upcoming stretch projects tougher; protect floor and reinforce depth."
 }
 
+// leaguePlayoffWeekStart returns the first playoff week (end of the regular season)
+func leaguePlayoffWeekStart(league map[string]interface{}) int {
+	if settings, ok := league["settings"].(map[string]interface{}); ok {
+		if start, ok := settings["playoff_week_start"].(float64); ok && start > 0 {
+			return int(start)
+		}
+	}
+	return 15
+}
+
+// buildScheduleOutlook rates each remaining opponent by league strength rank.
+// Opponents in the top third of the league are Hard, the bottom third Easy.
+func buildScheduleOutlook(weekly map[int][]map[string]interface{}, userRosterID int, strengths []TeamStrength) []ScheduleWeek {
+	outlook := []ScheduleWeek{}
+	if len(strengths) == 0 {
+		return outlook
+	}
+	ranks := make(map[int]int)
+	for i, s := range strengths {
+		ranks[s.RosterID] = i + 1
+	}
+	byRoster := teamStrengthByRoster(strengths)
+	third := float64(len(strengths)) / 3
+
+	weeks := make([]int, 0, len(weekly))
+	for w := range weekly {
+		weeks = append(weeks, w)
+	}
+	sort.Ints(weeks)
+
+	for _, w := range weeks {
+		oppID, _, _, ok := matchupOpponent(weekly[w], userRosterID)
+		if !ok {
+			continue
+		}
+		opp := byRoster[oppID]
+		rank := ranks[oppID]
+		difficulty := "Moderate"
+		if float64(rank) <= third {
+			difficulty = "Hard"
+		} else if float64(rank) > 2*third {
+			difficulty = "Easy"
+		}
+		outlook = append(outlook, ScheduleWeek{
+			Week:           w,
+			Opponent:       opp.TeamName,
+			OpponentRating: opp.Rating,
+			OpponentRank:   rank,
+			Difficulty:     difficulty,
+		})
+	}
+	return outlook
+}
+
+// summarizeScheduleOutlook condenses the week-by-week outlook into an overall difficulty
+func summarizeScheduleOutlook(outlook []ScheduleWeek) (string, string) {
+	hard, easy := 0, 0
+	toughest := outlook[0]
+	for _, w := range outlook {
+		switch w.Difficulty {
+		case "Hard":
+			hard++
+		case "Easy":
+			easy++
+		}
+		if w.OpponentRating > toughest.OpponentRating {
+			toughest = w
+		}
+	}
+
+	difficulty := "Moderate"
+	if hard > easy+1 {
+		difficulty = "Hard"
+	} else if easy > hard+1 {
+		difficulty = "Easy"
+	}
+	note := fmt.Sprintf("%d of %d remaining opponents rank in the league's top third, %d in the bottom third. Toughest: Week %d vs %s.",
+		hard, len(outlook), easy, toughest.Week, toughest.Opponent)
+	return difficulty, note
+}
+
 func parseWinProbPct(s string) int {
 	if s == "" {
 		return 0
@@ -33,3 +33,42 @@ func TestBuildScheduleDifficultyProfile(t *testing.T) {
 		t.Fatalf("expected Offseason difficulty, got %s", off)
 	}
 }
+
+func TestBuildScheduleOutlook(t *testing.T) {
+	strengths := []TeamStrength{
+		{RosterID: 2, TeamName: "Juggernaut", Rating: 90},
+		{RosterID: 1, TeamName: "You", Rating: 60},
+		{RosterID: 3, TeamName: "Middle", Rating: 50},
+		{RosterID: 4, TeamName: "Doormat", Rating: 10},
+	}
+	weekly := map[int][]map[string]interface{}{
+		10: {
+			{"roster_id": float64(1), "matchup_id": float64(1)},
+			{"roster_id": float64(2), "matchup_id": float64(1)},
+		},
+		11: {
+			{"roster_id": float64(1), "matchup_id": float64(2)},
+			{"roster_id": float64(4), "matchup_id": float64(2)},
+		},
+	}
+	outlook := buildScheduleOutlook(weekly, 1, strengths)
+	if len(outlook) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(outlook))
+	}
+	if outlook[0].Week != 10 || outlook[0].Difficulty != "Hard" || outlook[0].OpponentRank != 1 {
+		t.Fatalf("unexpected week 10 outlook: %+v", outlook[0])
+	}
+	if outlook[1].Difficulty != "Easy" {
+		t.Fatalf("expected easy week 11, got %+v", outlook[1])
+	}
+
+	league := LeagueData{HasMatchups: true, ScheduleOutlook: []ScheduleWeek{
+		{Week: 10, Difficulty: "Hard", OpponentRating: 90, Opponent: "A"},
+		{Week: 11, Difficulty: "Hard", OpponentRating: 80, Opponent: "B"},
+		{Week: 12, Difficulty: "Moderate", OpponentRating: 50, Opponent: "C"},
+	}}
+	diff, note := buildScheduleDifficultyProfile(league, "Contending")
+	if diff != "Hard" || note == "" {
+		t.Fatalf("expected Hard from schedule outlook, got %s (%s)", diff, note)
+	}
+}
@@ -0,0 +1,187 @@
+// ABOUTME: Weekly league snapshot archive and per-week player points cache for backtesting
+// ABOUTME: Stores one JSON file per league/week plus Sleeper weekly stats under a local archive directory
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultSnapshotDir = "/tmp/sleeperpy_snapshots"
+
+// snapshotArchiveDir returns the archive root, overridable with SLEEPERPY_SNAPSHOT_DIR
+func snapshotArchiveDir() string {
+	if dir := strings.TrimSpace(os.Getenv("SLEEPERPY_SNAPSHOT_DIR")); dir != "" {
+		return dir
+	}
+	return defaultSnapshotDir
+}
+
+func snapshotPath(dir, leagueID, season string, week int) string {
+	return filepath.Join(dir, sanitizePathSegment(leagueID), fmt.Sprintf("%s-wk%02d.json", sanitizePathSegment(season), week))
+}
+
+func weekPointsPath(dir, season string, week int) string {
+	return filepath.Join(dir, "points", fmt.Sprintf("%s-wk%02d.json", sanitizePathSegment(season), week))
+}
+
+// writeJSONFile writes through a temp file so readers never see a partial archive entry
+func writeJSONFile(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// archiveWeeklySnapshot saves the first lookup of each league week, which is the state the
+// recommendations were made from. Later lookups in the same week are ignored.
+func archiveWeeklySnapshot(dir string, league LeagueData, freeAgentsByPos map[string][]PlayerRow, week int) error {
+	if league.LeagueID == "" || week <= 0 {
+		return nil
+	}
+	path := snapshotPath(dir, league.LeagueID, league.Season, week)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	snap := WeeklySnapshot{
+		LeagueID:        league.LeagueID,
+		Season:          league.Season,
+		Week:            week,
+		SavedAt:         time.Now().UTC(),
+		League:          league,
+		FreeAgentsByPos: freeAgentsByPos,
+	}
+	if err := writeJSONFile(path, snap); err != nil {
+		return err
+	}
+	debugLog("[DEBUG] Archived week %d snapshot for league %s", week, league.LeagueID)
+	return nil
+}
+
+// loadWeeklySnapshots reads archived snapshots for one league (or every league when leagueID
+// is empty), ordered by league, season, and week
+func loadWeeklySnapshots(dir, leagueID string) ([]WeeklySnapshot, error) {
+	pattern := filepath.Join(dir, "*", "*-wk*.json")
+	if leagueID != "" {
+		pattern = filepath.Join(dir, sanitizePathSegment(leagueID), "*-wk*.json")
+	}
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	snaps := []WeeklySnapshot{}
+	for _, path := range paths {
+		if filepath.Base(filepath.Dir(path)) == "points" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var snap WeeklySnapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		restoreSnapshotTiers(&snap)
+		snaps = append(snaps, snap)
+	}
+	sort.Slice(snaps, func(i, j int) bool {
+		a, b := snaps[i], snaps[j]
+		if a.LeagueID != b.LeagueID {
+			return a.LeagueID < b.LeagueID
+		}
+		if a.Season != b.Season {
+			return a.Season < b.Season
+		}
+		return a.Week < b.Week
+	})
+	return snaps, nil
+}
+
+// restoreSnapshotTiers turns JSON-decoded whole-number tiers back into ints, which the
+// roster and waiver code type-asserts on
+func restoreSnapshotTiers(snap *WeeklySnapshot) {
+	fix := func(rows []PlayerRow) {
+		for i := range rows {
+			if f, ok := rows[i].Tier.(float64); ok && f == float64(int(f)) {
+				rows[i].Tier = int(f)
+			}
+		}
+	}
+	l := &snap.League
+	for _, rows := range [][]PlayerRow{l.Starters, l.Unranked, l.Bench, l.BenchUnranked, l.TopFreeAgents, l.TopFreeAgentsByValue} {
+		fix(rows)
+	}
+	for _, rows := range snap.FreeAgentsByPos {
+		fix(rows)
+	}
+}
+
+// PlayerPoints is one player's fantasy points for a week in each scoring format
+type PlayerPoints struct {
+	PPR     float64 `json:"ppr"`
+	HalfPPR float64 `json:"half_ppr"`
+	Std     float64 `json:"std"`
+}
+
+// forScoring picks the points matching a Boris Chen scoring label
+func (p PlayerPoints) forScoring(scoring string) float64 {
+	switch scoring {
+	case "Half PPR":
+		return p.HalfPPR
+	case "Standard":
+		return p.Std
+	}
+	return p.PPR
+}
+
+// loadWeekPoints returns player_id -> points for a completed week, reading the archive first
+// and archiving Sleeper's stats on a miss
+func loadWeekPoints(dir, season string, week int, provider LeagueProvider) (map[string]PlayerPoints, error) {
+	path := weekPointsPath(dir, season, week)
+	if data, err := os.ReadFile(path); err == nil {
+		var points map[string]PlayerPoints
+		if err := json.Unmarshal(data, &points); err == nil {
+			return points, nil
+		}
+	}
+
+	stats, err := provider.FetchWeekStats(season, week)
+	if err != nil {
+		return nil, err
+	}
+	points := make(map[string]PlayerPoints, len(stats))
+	for pid, raw := range stats {
+		line, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ppr, _ := line["pts_ppr"].(float64)
+		half, _ := line["pts_half_ppr"].(float64)
+		std, _ := line["pts_std"].(float64)
+		if ppr == 0 && half == 0 && std == 0 {
+			continue
+		}
+		points[pid] = PlayerPoints{PPR: ppr, HalfPPR: half, Std: std}
+	}
+	if len(points) > 0 {
+		if err := writeJSONFile(path, points); err != nil {
+			return points, err
+		}
+	}
+	return points, nil
+}
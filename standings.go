@@ -0,0 +1,135 @@
+// ABOUTME: League standings and tiebreakers built from Sleeper roster settings
+// ABOUTME: Orders teams by wins, ties, and points-for; playoff seeding puts division winners first
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// StandingsEntry is one team's regular-season record and standing
+type StandingsEntry struct {
+	RosterID         int
+	TeamName         string
+	Wins             int
+	Losses           int
+	Ties             int
+	PointsFor        float64
+	PointsAgainst    float64
+	Division         int
+	Rank             int // Overall standing by record (1 = best)
+	Seed             int // Playoff seed: division leaders ahead of wild cards
+	DivisionRank     int
+	IsDivisionLeader bool
+	IsUserTeam       bool
+}
+
+// Record formats the entry's W-L(-T) record
+func (e StandingsEntry) Record() string {
+	return formatRecord(e.Wins, e.Losses, e.Ties)
+}
+
+// WinPct counts ties as half a win
+func (e StandingsEntry) WinPct() float64 {
+	games := e.Wins + e.Losses + e.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(e.Wins) + float64(e.Ties)/2) / float64(games)
+}
+
+// hasMedianGames reports whether the league plays an extra game against the weekly median.
+// Sleeper already folds median results into each roster's wins and losses.
+func hasMedianGames(league map[string]interface{}) bool {
+	if settings, ok := league["settings"].(map[string]interface{}); ok {
+		if v, ok := settings["league_average_match"].(float64); ok {
+			return v == 1
+		}
+	}
+	return false
+}
+
+// computeStandings builds ranked standings from roster settings
+func computeStandings(rosters []map[string]interface{}, userNames map[string]string, userID string) []StandingsEntry {
+	entries := []StandingsEntry{}
+	for _, r := range rosters {
+		rosterID, _ := r["roster_id"].(float64)
+		ownerID, _ := r["owner_id"].(string)
+		entries = append(entries, StandingsEntry{
+			RosterID:      int(rosterID),
+			TeamName:      rosterTeamName(r, userNames),
+			Wins:          int(rosterSettingFloat(r, "wins")),
+			Losses:        int(rosterSettingFloat(r, "losses")),
+			Ties:          int(rosterSettingFloat(r, "ties")),
+			PointsFor:     rosterPointsFor(r),
+			PointsAgainst: rosterSettingFloat(r, "fpts_against") + rosterSettingFloat(r, "fpts_against_decimal")/100,
+			Division:      int(rosterSettingFloat(r, "division")),
+			IsUserTeam:    userID != "" && ownerID == userID,
+		})
+	}
+	return rankStandings(entries)
+}
+
+// rankStandings sorts by wins, then ties, then points-for. Rank follows that record
+// order; Seed also follows it unless the league has two or more divisions, in which
+// case each division leader is seeded ahead of the wild cards.
+func rankStandings(entries []StandingsEntry) []StandingsEntry {
+	ordered := append([]StandingsEntry{}, entries...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Wins != ordered[j].Wins {
+			return ordered[i].Wins > ordered[j].Wins
+		}
+		if ordered[i].Ties != ordered[j].Ties {
+			return ordered[i].Ties > ordered[j].Ties
+		}
+		return ordered[i].PointsFor > ordered[j].PointsFor
+	})
+
+	divisionCounts := make(map[int]int)
+	for i := range ordered {
+		ordered[i].Rank = i + 1
+		ordered[i].IsDivisionLeader = false
+		if ordered[i].Division > 0 {
+			divisionCounts[ordered[i].Division]++
+			ordered[i].DivisionRank = divisionCounts[ordered[i].Division]
+			ordered[i].IsDivisionLeader = ordered[i].DivisionRank == 1
+		}
+	}
+
+	seed := 1
+	for i := range ordered {
+		if len(divisionCounts) < 2 || ordered[i].IsDivisionLeader {
+			ordered[i].Seed = seed
+			seed++
+		}
+	}
+	for i := range ordered {
+		if ordered[i].Seed == 0 {
+			ordered[i].Seed = seed
+			seed++
+		}
+	}
+	return ordered
+}
+
+// playoffSeeding ranks entries and returns them in playoff seed order
+func playoffSeeding(entries []StandingsEntry) []StandingsEntry {
+	seeded := rankStandings(entries)
+	sort.SliceStable(seeded, func(i, j int) bool { return seeded[i].Seed < seeded[j].Seed })
+	return seeded
+}
+
+// standingsByRoster indexes standings by roster ID
+func standingsByRoster(standings []StandingsEntry) map[int]StandingsEntry {
+	m := make(map[int]StandingsEntry, len(standings))
+	for _, e := range standings {
+		m[e.RosterID] = e
+	}
+	return m
+}
+
+// standingLabel formats a record with its place, e.g. "8-5 (3rd)"
+func standingLabel(e StandingsEntry) string {
+	return fmt.Sprintf("%s (%d%s)", e.Record(), e.Rank, getRankSuffix(e.Rank))
+}
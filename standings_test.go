@@ -0,0 +1,65 @@
+package main
+
+import "testing"
+
+func TestComputeStandingsTiebreakers(t *testing.T) {
+	rosters := []map[string]interface{}{
+		{"roster_id": float64(1), "owner_id": "a", "settings": map[string]interface{}{"wins": float64(7), "losses": float64(5), "fpts": float64(1400), "fpts_decimal": float64(10)}},
+		{"roster_id": float64(2), "owner_id": "b", "settings": map[string]interface{}{"wins": float64(7), "losses": float64(5), "fpts": float64(1400), "fpts_decimal": float64(90)}},
+		{"roster_id": float64(3), "owner_id": "c", "settings": map[string]interface{}{"wins": float64(7), "losses": float64(4), "ties": float64(1), "fpts": float64(1200)}},
+		{"roster_id": float64(4), "owner_id": "d", "settings": map[string]interface{}{"wins": float64(9), "losses": float64(3), "fpts": float64(1100)}},
+	}
+	standings := computeStandings(rosters, map[string]string{"b": "Bee"}, "b")
+	order := []int{}
+	for _, e := range standings {
+		order = append(order, e.RosterID)
+	}
+	want := []int{4, 3, 2, 1}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("expected order %v, got %v", want, order)
+		}
+	}
+	if standings[2].TeamName != "Bee" || !standings[2].IsUserTeam || standings[2].Rank != 3 {
+		t.Fatalf("unexpected user entry: %+v", standings[2])
+	}
+	if standings[2].PointsFor != 1400.9 {
+		t.Fatalf("expected fpts_decimal folded in, got %.2f", standings[2].PointsFor)
+	}
+	if standings[1].Record() != "7-4-1" {
+		t.Fatalf("expected tie in record, got %s", standings[1].Record())
+	}
+}
+
+func TestRankStandingsDivisions(t *testing.T) {
+	entries := []StandingsEntry{
+		{RosterID: 1, Wins: 10, Division: 1},
+		{RosterID: 2, Wins: 9, Division: 1},
+		{RosterID: 3, Wins: 4, Division: 2},
+		{RosterID: 4, Wins: 3, Division: 2},
+	}
+	ranked := rankStandings(entries)
+	if ranked[1].RosterID != 2 || ranked[1].Rank != 2 || ranked[1].Seed != 3 || ranked[1].DivisionRank != 2 {
+		t.Fatalf("expected the wild card second by record but seeded third, got %+v", ranked[1])
+	}
+	if ranked[2].RosterID != 3 || !ranked[2].IsDivisionLeader || ranked[2].Rank != 3 || ranked[2].Seed != 2 {
+		t.Fatalf("expected the division 2 leader third by record but seeded second, got %+v", ranked[2])
+	}
+	seeded := playoffSeeding(entries)
+	if seeded[1].RosterID != 3 || seeded[2].RosterID != 2 {
+		t.Fatalf("expected division leaders seeded first, got %+v", seeded)
+	}
+	if slots := pickSlotsFromStandings(ranked); slots[3] != 2 || slots[2] != 3 {
+		t.Fatalf("expected draft slots by record, got %v", slots)
+	}
+}
+
+func TestHasMedianGames(t *testing.T) {
+	league := map[string]interface{}{"settings": map[string]interface{}{"league_average_match": float64(1)}}
+	if !hasMedianGames(league) {
+		t.Fatalf("expected median games enabled")
+	}
+	if hasMedianGames(map[string]interface{}{}) {
+		t.Fatalf("expected median games disabled by default")
+	}
+}
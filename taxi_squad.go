@@ -0,0 +1,151 @@
+// ABOUTME: Taxi squad analysis for dynasty leagues
+// ABOUTME: Suggests taxi promotions/demotions and flags players running out of taxi eligibility
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseTaxiSettings reads taxi squad configuration from league settings
+func parseTaxiSettings(league map[string]interface{}) TaxiSettings {
+	settings := TaxiSettings{}
+	s, ok := league["settings"].(map[string]interface{})
+	if !ok {
+		return settings
+	}
+	if slots, ok := s["taxi_slots"].(float64); ok {
+		settings.Slots = int(slots)
+	}
+	if years, ok := s["taxi_years"].(float64); ok {
+		settings.Years = int(years)
+	}
+	if vets, ok := s["taxi_allow_vets"].(float64); ok {
+		settings.AllowVets = vets == 1
+	}
+	return settings
+}
+
+// isTaxiEligible reports whether a player could be placed on the taxi squad
+func isTaxiEligible(row PlayerRow, settings TaxiSettings) bool {
+	if settings.AllowVets {
+		return true
+	}
+	return row.YearsExp < settings.Years
+}
+
+// buildTaxiSuggestions compares the taxi squad against the active roster.
+// Taxi players who out-tier a starter or bench player at their position should be promoted,
+// players in their final eligible season are flagged, and low-value bench rookies
+// are suggested as demotions when taxi slots are open.
+// All rows must carry position tiers (built as non-starters) so taxi and active players compare on one scale.
+func buildTaxiSuggestions(taxi []PlayerRow, starters []PlayerRow, bench []PlayerRow, benchUnranked []PlayerRow, settings TaxiSettings) []TaxiSuggestion {
+	var suggestions []TaxiSuggestion
+	if settings.Slots == 0 {
+		return suggestions
+	}
+
+	promoted := make(map[string]bool)
+	for _, t := range taxi {
+		taxiTier := parseTierFloat(t.Tier)
+		if taxiTier == 0 {
+			continue
+		}
+
+		// Prefer beating a starter, then the weakest bench player at the position
+		counterpart := ""
+		counterpartTier := 0.0
+		role := ""
+		for _, s := range starters {
+			st := parseTierFloat(s.Tier)
+			if rowPosition(s) == rowPosition(t) && st > taxiTier && st > counterpartTier {
+				counterpart = stripHTML(s.Name)
+				counterpartTier = st
+				role = "starter"
+			}
+		}
+		if counterpart == "" {
+			for _, b := range bench {
+				if strings.Contains(b.Name, "(IR)") {
+					continue
+				}
+				bt := parseTierFloat(b.Tier)
+				if rowPosition(b) == rowPosition(t) && bt > taxiTier && bt > counterpartTier {
+					counterpart = stripHTML(b.Name)
+					counterpartTier = bt
+					role = "bench player"
+				}
+			}
+		}
+		if counterpart == "" {
+			continue
+		}
+
+		promoted[t.Name] = true
+		suggestions = append(suggestions, TaxiSuggestion{
+			Type:        "promote",
+			PlayerName:  stripHTML(t.Name),
+			Position:    rowPosition(t),
+			Counterpart: counterpart,
+			Reason:      fmt.Sprintf("Tier %.0f on taxi vs tier %.0f %s %s", taxiTier, counterpartTier, role, counterpart),
+		})
+	}
+
+	// Flag players in their final season of taxi eligibility
+	if !settings.AllowVets && settings.Years > 0 {
+		for _, t := range taxi {
+			if promoted[t.Name] || t.YearsExp < settings.Years-1 {
+				continue
+			}
+			suggestions = append(suggestions, TaxiSuggestion{
+				Type:       "expiring",
+				PlayerName: stripHTML(t.Name),
+				Position:   t.Pos,
+				Reason:     fmt.Sprintf("Final taxi-eligible season (%d yrs exp, limit %d) - promote or cut before next season", t.YearsExp, settings.Years),
+			})
+		}
+	}
+
+	// Suggest stashing eligible bench players when taxi slots are open
+	openSlots := settings.Slots - len(taxi)
+	if openSlots <= 0 {
+		return suggestions
+	}
+	candidates := []PlayerRow{}
+	candidates = append(candidates, benchUnranked...)
+	var worstBench *PlayerRow
+	for i := range bench {
+		b := bench[i]
+		if strings.Contains(b.Name, "(IR)") || !isTaxiEligible(b, settings) {
+			continue
+		}
+		if worstBench == nil || parseTierFloat(b.Tier) > parseTierFloat(worstBench.Tier) {
+			worstBench = &bench[i]
+		}
+	}
+	if worstBench != nil {
+		candidates = append(candidates, *worstBench)
+	}
+	for _, c := range candidates {
+		if openSlots == 0 {
+			break
+		}
+		if strings.Contains(c.Name, "(IR)") || !isTaxiEligible(c, settings) {
+			continue
+		}
+		reason := "Unranked bench player - stash on taxi to free a roster spot"
+		if t := parseTierFloat(c.Tier); t > 0 {
+			reason = fmt.Sprintf("Lowest-tier eligible bench player (tier %.0f) - stash on taxi to free a roster spot", t)
+		}
+		suggestions = append(suggestions, TaxiSuggestion{
+			Type:       "demote",
+			PlayerName: stripHTML(c.Name),
+			Position:   c.Pos,
+			Reason:     reason,
+		})
+		openSlots--
+	}
+
+	return suggestions
+}
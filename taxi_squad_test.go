@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestParseTaxiSettings(t *testing.T) {
+	league := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"taxi_slots":      float64(4),
+			"taxi_years":      float64(2),
+			"taxi_allow_vets": float64(0),
+		},
+	}
+	got := parseTaxiSettings(league)
+	if got.Slots != 4 || got.Years != 2 || got.AllowVets {
+		t.Fatalf("unexpected taxi settings: %+v", got)
+	}
+}
+
+func TestBuildTaxiSuggestionsPromote(t *testing.T) {
+	settings := TaxiSettings{Slots: 3, Years: 2}
+	taxi := []PlayerRow{{Pos: "WR", Name: "Rookie Stud", Tier: 3, YearsExp: 0}}
+	starters := []PlayerRow{{Pos: "WR", Name: "Old Vet", Tier: 6}}
+	bench := []PlayerRow{{Pos: "WR", Name: "Depth Guy", Tier: 8, YearsExp: 5}}
+
+	suggestions := buildTaxiSuggestions(taxi, starters, bench, nil, settings)
+	if len(suggestions) == 0 || suggestions[0].Type != "promote" {
+		t.Fatalf("expected promote suggestion, got %+v", suggestions)
+	}
+	if suggestions[0].Counterpart != "Old Vet" {
+		t.Fatalf("expected promotion over starter, got %s", suggestions[0].Counterpart)
+	}
+}
+
+func TestBuildTaxiSuggestionsExpiringAndDemote(t *testing.T) {
+	settings := TaxiSettings{Slots: 2, Years: 2}
+	taxi := []PlayerRow{{Pos: "RB", Name: "Second Year", Tier: 9, YearsExp: 1}}
+	bench := []PlayerRow{
+		{Pos: "RB", Name: "Veteran", Tier: 5, YearsExp: 6},
+		{Pos: "TE", Name: "Rookie TE", Tier: 7, YearsExp: 0},
+	}
+
+	suggestions := buildTaxiSuggestions(taxi, nil, bench, nil, settings)
+	var expiring, demote bool
+	for _, s := range suggestions {
+		switch s.Type {
+		case "expiring":
+			expiring = s.PlayerName == "Second Year"
+		case "demote":
+			if s.PlayerName == "Veteran" {
+				t.Fatalf("veteran should not be taxi eligible")
+			}
+			demote = s.PlayerName == "Rookie TE"
+		}
+	}
+	if !expiring || !demote {
+		t.Fatalf("expected expiring and demote suggestions, got %+v", suggestions)
+	}
+}
+
+func TestBuildTaxiSuggestionsNoTaxiSlots(t *testing.T) {
+	taxi := []PlayerRow{{Pos: "WR", Name: "Rookie", Tier: 1}}
+	if got := buildTaxiSuggestions(taxi, nil, nil, nil, TaxiSettings{}); len(got) != 0 {
+		t.Fatalf("expected no suggestions without taxi slots, got %+v", got)
+	}
+}
+
+func TestBuildTaxiSuggestionsFlexStarter(t *testing.T) {
+	settings := TaxiSettings{Slots: 3, Years: 2}
+	taxi := []PlayerRow{{Pos: "WR", RealPos: "WR", Name: "Rookie Stud", Tier: 3}}
+	starters := []PlayerRow{{Pos: "FLEX", RealPos: "WR", Name: "Flex Vet", Tier: 6}}
+
+	suggestions := buildTaxiSuggestions(taxi, starters, nil, nil, settings)
+	if len(suggestions) == 0 || suggestions[0].Counterpart != "Flex Vet" || suggestions[0].Position != "WR" {
+		t.Fatalf("expected promotion over the flex starter at its real position, got %+v", suggestions)
+	}
+}
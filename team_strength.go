@@ -0,0 +1,295 @@
+// ABOUTME: League-wide team strength ratings and optimal lineup construction
+// ABOUTME: Rates every roster from its best lineup tiers, points-for, and dynasty value
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// lineupCandidate is a player eligible for a lineup slot; higher Score is better
+type lineupCandidate struct {
+	ID    string
+	Name  string
+	Pos   string
+	Score float64
+}
+
+// lineupSlotOrder lists the most restrictive slots first so flex spots get the leftovers
+var lineupSlotOrder = []string{"QB", "RB", "WR", "TE", "K", "DEF", "DL", "LB", "DB", "WRRB_FLEX", "REC_FLEX", "FLEX", "SUPER_FLEX", "IDP_FLEX"}
+
+// slotAccepts reports whether a player position can fill a roster slot
+func slotAccepts(slot, pos string) bool {
+	if pos == "DST" {
+		pos = "DEF"
+	}
+	switch slot {
+	case "FLEX":
+		return pos == "RB" || pos == "WR" || pos == "TE"
+	case "WRRB_FLEX":
+		return pos == "RB" || pos == "WR"
+	case "REC_FLEX":
+		return pos == "WR" || pos == "TE"
+	case "SUPER_FLEX":
+		return pos == "QB" || pos == "RB" || pos == "WR" || pos == "TE"
+	case "IDP_FLEX":
+		return pos == "DL" || pos == "LB" || pos == "DB"
+	default:
+		return slot == pos
+	}
+}
+
+// optimalLineup fills starting slots with the highest-scoring eligible candidates.
+// Candidates are taken best-first and kept whenever they can still be seated, moving
+// earlier picks between slots when needed; because lineups that fit the slots form a
+// matroid, this yields the best possible total even with overlapping flex slots.
+// Returns the chosen candidates in slot order and their total score.
+func optimalLineup(slots []string, candidates []lineupCandidate) ([]lineupCandidate, float64) {
+	sorted := append([]lineupCandidate{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Score > sorted[j].Score
+	})
+
+	slotRank := func(slot string) int {
+		for i, s := range lineupSlotOrder {
+			if s == slot {
+				return i
+			}
+		}
+		return len(lineupSlotOrder)
+	}
+	ordered := append([]string{}, slots...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return slotRank(ordered[i]) < slotRank(ordered[j])
+	})
+
+	holder := make([]int, len(ordered)) // Index into sorted, -1 while the slot is open
+	for i := range holder {
+		holder[i] = -1
+	}
+	var seat func(c int, visited []bool) bool
+	seat = func(c int, visited []bool) bool {
+		for s, slot := range ordered {
+			if visited[s] || !slotAccepts(slot, sorted[c].Pos) {
+				continue
+			}
+			visited[s] = true
+			if holder[s] < 0 || seat(holder[s], visited) {
+				holder[s] = c
+				return true
+			}
+		}
+		return false
+	}
+	filled := 0
+	for c := range sorted {
+		if filled == len(ordered) {
+			break
+		}
+		if seat(c, make([]bool, len(ordered))) {
+			filled++
+		}
+	}
+
+	lineup := []lineupCandidate{}
+	total := 0.0
+	for _, c := range holder {
+		if c >= 0 {
+			lineup = append(lineup, sorted[c])
+			total += sorted[c].Score
+		}
+	}
+	return lineup, total
+}
+
+// starterSlots returns the starting lineup slots from league roster_positions
+func starterSlots(rosterPositions []string) []string {
+	slots := []string{}
+	for _, pos := range rosterPositions {
+		if pos == "BN" || pos == "IR" || pos == "TAXI" {
+			continue
+		}
+		slots = append(slots, pos)
+	}
+	return slots
+}
+
+// tierLineupScore converts a tier into a lineup score (tier 1 scores highest)
+func tierLineupScore(tier int) float64 {
+	if tier <= 0 {
+		return 0
+	}
+	return math.Max(1, 20-float64(tier))
+}
+
+// bestLineup fills the optimal lineup by tier from a roster's active players.
+// Returns the lineup and its average starter tier (0 when no starter is ranked).
+func bestLineup(active []string, players map[string]interface{}, tiers map[string][][]string, slots []string) ([]lineupCandidate, float64) {
+	candidates := []lineupCandidate{}
+	tierByID := make(map[string]int)
+	for _, pid := range active {
+		p, ok := players[pid].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		pos, _ := p["position"].(string)
+		lookupPos := pos
+		if lookupPos == "DEF" {
+			lookupPos = "DST"
+		}
+		name := getPlayerName(p)
+		tier := findTier(tiers[lookupPos], name)
+		tierByID[pid] = tier
+		candidates = append(candidates, lineupCandidate{ID: pid, Name: name, Pos: pos, Score: tierLineupScore(tier)})
+	}
+
+	lineup, _ := optimalLineup(slots, candidates)
+	tierSum := 0
+	tierCount := 0
+	for _, c := range lineup {
+		if t := tierByID[c.ID]; t > 0 {
+			tierSum += t
+			tierCount++
+		}
+	}
+	if tierCount == 0 {
+		return lineup, 0
+	}
+	return lineup, float64(tierSum) / float64(tierCount)
+}
+
+// rosterSettingFloat reads a numeric value from a roster's settings map
+func rosterSettingFloat(r map[string]interface{}, key string) float64 {
+	if settings, ok := r["settings"].(map[string]interface{}); ok {
+		if v, ok := settings[key].(float64); ok {
+			return v
+		}
+	}
+	return 0
+}
+
+// rosterPointsFor combines Sleeper's fpts and fpts_decimal fields
+func rosterPointsFor(r map[string]interface{}) float64 {
+	return rosterSettingFloat(r, "fpts") + rosterSettingFloat(r, "fpts_decimal")/100
+}
+
+// calculateTeamStrengths rates every roster in the league, strongest first.
+// Rating (0-100) blends best-lineup tiers, points per game, and dynasty value when available.
+func calculateTeamStrengths(rosters []map[string]interface{}, players map[string]interface{}, tiers map[string][][]string, dynastyValues map[string]DynastyValue, isSuperFlex bool, rosterPositions []string, userNames map[string]string) []TeamStrength {
+	slots := starterSlots(rosterPositions)
+	strengths := []TeamStrength{}
+
+	for _, r := range rosters {
+		rosterID, _ := r["roster_id"].(float64)
+		inactive := append(toStringSlice(r["reserve"]), toStringSlice(r["taxi"])...)
+		active := diff(toStringSlice(r["players"]), inactive)
+
+		rosterValue := 0
+		for _, pid := range toStringSlice(r["players"]) {
+			p, ok := players[pid].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := getPlayerName(p)
+			if dynastyValues != nil {
+				rosterValue += getDynastyValue(name, dynastyValues, isSuperFlex)
+			}
+		}
+		_, starterTier := bestLineup(active, players, tiers, slots)
+
+		games := int(rosterSettingFloat(r, "wins") + rosterSettingFloat(r, "losses") + rosterSettingFloat(r, "ties"))
+		strengths = append(strengths, TeamStrength{
+			RosterID:    int(rosterID),
+			TeamName:    rosterTeamName(r, userNames),
+			StarterTier: starterTier,
+			RosterValue: rosterValue,
+			PointsFor:   rosterPointsFor(r),
+			GamesPlayed: games,
+		})
+	}
+
+	rateTeamStrengths(strengths)
+	sort.Slice(strengths, func(i, j int) bool {
+		return strengths[i].Rating > strengths[j].Rating
+	})
+	return strengths
+}
+
+// rateTeamStrengths min-max normalizes each component across the league and blends them
+func rateTeamStrengths(strengths []TeamStrength) {
+	if len(strengths) == 0 {
+		return
+	}
+	tierScores := make([]float64, len(strengths))
+	ppg := make([]float64, len(strengths))
+	values := make([]float64, len(strengths))
+	for i, s := range strengths {
+		if s.StarterTier > 0 {
+			tierScores[i] = -s.StarterTier // Lower tier is better
+		} else {
+			tierScores[i] = math.Inf(-1)
+		}
+		if s.GamesPlayed > 0 {
+			ppg[i] = s.PointsFor / float64(s.GamesPlayed)
+		}
+		values[i] = float64(s.RosterValue)
+	}
+
+	components := []struct {
+		scores []float64
+		weight float64
+	}{
+		{normalizeScores(tierScores), 0.4},
+		{normalizeScores(ppg), 0.4},
+		{normalizeScores(values), 0.2},
+	}
+	for i := range strengths {
+		total := 0.0
+		weights := 0.0
+		for _, c := range components {
+			if c.scores == nil {
+				continue // Component has no spread across the league
+			}
+			total += c.scores[i] * c.weight
+			weights += c.weight
+		}
+		if weights > 0 {
+			strengths[i].Rating = math.Round(total/weights*1000) / 10
+		} else {
+			strengths[i].Rating = 50
+		}
+	}
+}
+
+// normalizeScores maps values onto 0..1; returns nil when all values are equal
+func normalizeScores(values []float64) []float64 {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsInf(v, 0) {
+			continue
+		}
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	if math.IsInf(lo, 0) || hi-lo < 1e-9 {
+		return nil
+	}
+	out := make([]float64, len(values))
+	for i, v := range values {
+		if math.IsInf(v, 0) {
+			continue // Unrated teams score zero
+		}
+		out[i] = (v - lo) / (hi - lo)
+	}
+	return out
+}
+
+// teamStrengthByRoster indexes team strengths by roster ID
+func teamStrengthByRoster(strengths []TeamStrength) map[int]TeamStrength {
+	m := make(map[int]TeamStrength, len(strengths))
+	for _, s := range strengths {
+		m[s.RosterID] = s
+	}
+	return m
+}
@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestOptimalLineupFillsFlexWithLeftovers(t *testing.T) {
+	slots := []string{"QB", "RB", "WR", "FLEX", "SUPER_FLEX"}
+	candidates := []lineupCandidate{
+		{ID: "qb1", Pos: "QB", Score: 15},
+		{ID: "qb2", Pos: "QB", Score: 12},
+		{ID: "rb1", Pos: "RB", Score: 14},
+		{ID: "rb2", Pos: "RB", Score: 10},
+		{ID: "wr1", Pos: "WR", Score: 13},
+		{ID: "te1", Pos: "TE", Score: 4},
+	}
+	lineup, total := optimalLineup(slots, candidates)
+	if len(lineup) != 5 {
+		t.Fatalf("expected 5 starters, got %d", len(lineup))
+	}
+	// QB1 at QB, RB1 at RB, WR1 at WR, RB2 at FLEX, QB2 at SUPER_FLEX
+	if total != 15+14+13+10+12 {
+		t.Fatalf("unexpected lineup total %.1f: %+v", total, lineup)
+	}
+}
+
+func TestOptimalLineupOverlappingFlexSlots(t *testing.T) {
+	// Filling WRRB_FLEX with the WR first would strand the RB and leave REC_FLEX to the TE
+	slots := []string{"WRRB_FLEX", "REC_FLEX"}
+	candidates := []lineupCandidate{
+		{ID: "wr1", Pos: "WR", Score: 20},
+		{ID: "rb1", Pos: "RB", Score: 10},
+		{ID: "te1", Pos: "TE", Score: 5},
+	}
+	if _, total := optimalLineup(slots, candidates); total != 30 {
+		t.Fatalf("expected WR at REC_FLEX and RB at WRRB_FLEX for 30, got %.1f", total)
+	}
+}
+
+func TestSlotAccepts(t *testing.T) {
+	cases := []struct {
+		slot, pos string
+		want      bool
+	}{
+		{"FLEX", "TE", true},
+		{"FLEX", "QB", false},
+		{"REC_FLEX", "RB", false},
+		{"SUPER_FLEX", "QB", true},
+		{"DEF", "DST", true},
+	}
+	for _, c := range cases {
+		if got := slotAccepts(c.slot, c.pos); got != c.want {
+			t.Fatalf("slotAccepts(%s, %s) = %v, want %v", c.slot, c.pos, got, c.want)
+		}
+	}
+}
+
+func TestCalculateTeamStrengths(t *testing.T) {
+	players := map[string]interface{}{
+		"1": map[string]interface{}{"first_name": "Star", "last_name": "Back", "position": "RB"},
+		"2": map[string]interface{}{"first_name": "Slow", "last_name": "Back", "position": "RB"},
+	}
+	tiers := map[string][][]string{"RB": {{"Star Back"}, {}, {}, {}, {"Slow Back"}}}
+	rosters := []map[string]interface{}{
+		{"roster_id": float64(1), "players": []interface{}{"1"}, "settings": map[string]interface{}{"wins": float64(3), "fpts": float64(400)}},
+		{"roster_id": float64(2), "players": []interface{}{"2"}, "settings": map[string]interface{}{"wins": float64(1), "losses": float64(2), "fpts": float64(300), "fpts_decimal": float64(50)}},
+	}
+	strengths := calculateTeamStrengths(rosters, players, tiers, nil, false, []string{"RB", "BN"}, nil)
+	if len(strengths) != 2 || strengths[0].RosterID != 1 {
+		t.Fatalf("expected roster 1 strongest, got %+v", strengths)
+	}
+	if strengths[1].PointsFor != 300.5 || strengths[1].StarterTier != 5 {
+		t.Fatalf("unexpected roster 2 strength: %+v", strengths[1])
+	}
+	if strengths[0].Rating != 100 || strengths[1].Rating != 0 {
+		t.Fatalf("expected ratings 100/0, got %.1f/%.1f", strengths[0].Rating, strengths[1].Rating)
+	}
+}
@@ -18,6 +18,8 @@ func generateTradeProposal(
 	targetTeamName string,
 	userSurplus string,
 	targetSurplus string,
+	yourPicks []ProposalPlayer,
+	theirPicks []ProposalPlayer,
 	dynastyValues map[string]DynastyValue,
 	isSuperFlex bool,
 	premiumEnabled bool,
@@ -31,6 +33,7 @@ func generateTradeProposal(
 
 	// Build balanced trade (within 10% value)
 	yourOffer, theirReturn := buildBalancedTrade(yourOfferCandidates, theirOfferCandidates, 0.10)
+	yourOffer, theirReturn = balanceWithPicks(yourOffer, theirReturn, yourPicks, theirPicks, 0.10)
 
 	// Calculate value delta
 	yourOfferValue := sumPlayerValues(yourOffer)
@@ -90,6 +93,7 @@ func findSurplusPlayers(roster []PlayerRow, position string, dynastyValues map[s
 					Position:     player.Pos,
 					DynastyValue: dv,
 					Tier:         fmt.Sprintf("%v", player.Tier),
+					Age:          player.Age,
 				})
 			}
 		}
@@ -134,6 +138,38 @@ func buildBalancedTrade(yourCandidates, theirCandidates []ProposalPlayer, tolera
 	return []ProposalPlayer{your}, []ProposalPlayer{their}
 }
 
+// balanceWithPicks closes a value gap beyond tolerance by having the side receiving more
+// add the draft pick that best evens the deal. Picks that would widen the gap are skipped.
+func balanceWithPicks(yourOffer, theirReturn, yourPicks, theirPicks []ProposalPlayer, tolerance float64) ([]ProposalPlayer, []ProposalPlayer) {
+	if len(yourOffer) == 0 || len(theirReturn) == 0 {
+		return yourOffer, theirReturn
+	}
+	gap := sumPlayerValues(theirReturn) - sumPlayerValues(yourOffer)
+	avg := (sumPlayerValues(theirReturn) + sumPlayerValues(yourOffer)) / 2
+	if avg == 0 || float64(abs(gap))/float64(avg) <= tolerance {
+		return yourOffer, theirReturn
+	}
+
+	pool := yourPicks // You're getting more, so you add a pick
+	if gap < 0 {
+		pool = theirPicks
+	}
+	best := -1
+	bestGap := abs(gap)
+	for i, p := range pool {
+		if remaining := abs(abs(gap) - p.DynastyValue); remaining < bestGap {
+			best, bestGap = i, remaining
+		}
+	}
+	if best < 0 {
+		return yourOffer, theirReturn
+	}
+	if gap > 0 {
+		return append(append([]ProposalPlayer{}, yourOffer...), pool[best]), theirReturn
+	}
+	return yourOffer, append(append([]ProposalPlayer{}, theirReturn...), pool[best])
+}
+
 func sumPlayerValues(players []ProposalPlayer) int {
 	total := 0
 	for _, p := range players {
@@ -154,21 +190,34 @@ func calculateWinNowImpact(yourOffer, theirReturn []ProposalPlayer, dynastyValue
 }
 
 func calculateFutureImpact(yourOffer, theirReturn []ProposalPlayer, dynastyValues map[string]DynastyValue) int {
-	// Simplified: value delta represents future impact
-	valueDelta := sumPlayerValues(theirReturn) - sumPlayerValues(yourOffer)
+	// Compare what each side is projected to be worth in three seasons on the age curves
+	valueDelta := sumProjectedValues(theirReturn, AGE_CURVE_YEARS) - sumProjectedValues(yourOffer, AGE_CURVE_YEARS)
 
 	// Scale to -100 to +100
 	impact := valueDelta / 50
 	return clamp(impact, -100, 100)
 }
 
+// sumProjectedValues totals age-curve values the given seasons out; picks and unknown ages hold value
+func sumProjectedValues(players []ProposalPlayer, years int) int {
+	total := 0
+	for _, p := range players {
+		total += projectValue(p.Position, p.Age, p.DynastyValue, years)
+	}
+	return total
+}
+
 func estimateAverageAge(players []ProposalPlayer) float64 {
 	if len(players) == 0 {
 		return 26.0
 	}
-	// Simplified estimation based on position
+	// Actual ages when known, otherwise a typical age for the position
 	totalAge := 0.0
 	for _, p := range players {
+		if p.Age > 0 {
+			totalAge += float64(p.Age)
+			continue
+		}
 		switch p.Position {
 		case "QB":
 			totalAge += 28.0
@@ -178,6 +227,8 @@ func estimateAverageAge(players []ProposalPlayer) float64 {
 			totalAge += 26.5
 		case "TE":
 			totalAge += 27.0
+		case "PICK":
+			totalAge += 21.0 // Incoming rookie
 		default:
 			totalAge += 26.0
 		}
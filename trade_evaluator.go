@@ -0,0 +1,809 @@
+// ABOUTME: Trade evaluator for any mix of players and picks between two rosters in a league
+// ABOUTME: Reports value delta, fairness, positional impact, lineup tiers, age shift, and contention-window fit
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TRADE_TIER_EPSILON = 0.05 // Smaller moves in average starter tier count as no change
+	TRADE_AGE_EPSILON  = 0.3  // Smaller moves in average roster age count as no change
+	tradePickPrefix    = "pick:"
+)
+
+// Window fit verdicts
+const (
+	TradeFitsWindow    = "Fits"
+	TradeNeutralWindow = "Neutral"
+	TradeAgainstWindow = "Against"
+)
+
+// tradePickToken matches typed picks like "2027 1st", "2027 Round 1", "2027 Pick 1.03", or "2027 1.03"
+var tradePickToken = regexp.MustCompile(`(?i)^(\d{4})\s+(?:pick\s+|round\s+|rd\s*)?(\d{1,2})(?:st|nd|rd|th)?(?:\.(\d{1,2}))?\b`)
+
+// TradeAsset is a player or pick that can go into an evaluated trade
+type TradeAsset struct {
+	ID       string // Sleeper player ID, or "pick:YEAR-ROUND-ORIGINAL_ROSTER_ID"
+	Name     string
+	Position string // "PICK" for draft picks
+	Tier     int    // 0 for picks and unranked players
+	Age      int
+	Value    int
+	Selected bool // Part of the trade being evaluated
+}
+
+// proposal converts the asset for the trade coach's impact helpers
+func (a TradeAsset) proposal() ProposalPlayer {
+	tier := ""
+	if a.Tier > 0 {
+		tier = strconv.Itoa(a.Tier)
+	}
+	return ProposalPlayer{Name: a.Name, Position: a.Position, DynastyValue: a.Value, Tier: tier, Age: a.Age}
+}
+
+// TradePositionImpact is how a trade moves one team at one position of the league matrix
+type TradePositionImpact struct {
+	Position     string
+	ValueBefore  int
+	ValueAfter   int
+	RankBefore   int
+	RankAfter    int
+	StatusBefore string // "Surplus", "Need", or ""
+	StatusAfter  string
+}
+
+// TradeSideImpact is how a trade changes one team
+type TradeSideImpact struct {
+	RosterID         int
+	TeamName         string
+	Sends            []TradeAsset
+	Receives         []TradeAsset
+	SendValue        int
+	ReceiveValue     int
+	LineupTierBefore float64  // Average tier of the optimal lineup (lower is better, 0 = unranked)
+	LineupTierAfter  float64  // Same lineup measure with the trade applied
+	StartersIn       []string // Players who join the optimal lineup
+	StartersOut      []string // Players who leave it
+	AvgAgeBefore     float64
+	AvgAgeAfter      float64
+	Positions        []TradePositionImpact
+	WindowBefore     string // Dynasty only
+	WindowAfter      string
+}
+
+// TradeEvaluation is the full read on one trade from the user's side
+type TradeEvaluation struct {
+	LeagueID      string
+	LeagueName    string
+	IsDynasty     bool
+	You           TradeSideImpact
+	Them          TradeSideImpact
+	ValueDelta    int     // What you receive minus what you send
+	ValueDeltaPct float64 // Gap as a percent of the smaller side
+	Verdict       string  // "Fair", "Slight win", "Big win", "Slight overpay", "Overpay"; redraft: lineup upgrade/downgrade
+	Fairness      TradeFairness
+	WinNowImpact  int    // -100 to +100
+	FutureImpact  int    // -100 to +100
+	WindowFit     string // "Fits", "Neutral", or "Against" (dynasty only)
+	WindowNote    string
+	Unmatched     []string // Requested assets that aren't on either roster
+}
+
+// Sides lists the user's side first, then the partner's
+func (ev TradeEvaluation) Sides() []TradeSideImpact {
+	return []TradeSideImpact{ev.You, ev.Them}
+}
+
+// TradeTeamOption is a team the user can trade with
+type TradeTeamOption struct {
+	RosterID int
+	Name     string
+}
+
+// TradeEvaluatorPage is the trade calculator form and, once assets are picked, its evaluation
+type TradeEvaluatorPage struct {
+	LeagueID        string
+	LeagueName      string
+	Username        string
+	DraftOrder      string // Draft order method the picks are slotted by
+	IsDynasty       bool
+	YourTeam        string
+	Teams           []TradeTeamOption
+	PartnerRosterID int
+	YourAssets      []TradeAsset
+	TheirAssets     []TradeAsset
+	Evaluation      *TradeEvaluation
+	Error           string
+}
+
+func tradeEvaluatorHandler(w http.ResponseWriter, r *http.Request) {
+	trackPageView(r.URL.Path)
+	q := r.URL.Query()
+	leagueID := strings.TrimSpace(q.Get("league_id"))
+	if leagueID == "" {
+		http.Error(w, "league_id is required", http.StatusBadRequest)
+		return
+	}
+	username := strings.TrimSpace(q.Get("user"))
+	if username == "" {
+		if cookie, err := r.Cookie("sleeper_username"); err == nil {
+			username = cookie.Value
+		}
+	}
+	partner, _ := strconv.Atoi(q.Get("partner"))
+
+	method := parseDraftOrderMethod(q.Get("draft_order"))
+	page, err := buildTradeEvaluatorPage(leagueID, username, method, partner, splitTradeAssets(q["give"]), splitTradeAssets(q["get"]))
+	if err != nil {
+		log.Printf("[ERROR] Trade evaluator failed for league %s: %v", leagueID, err)
+		totalErrors.Inc()
+		http.Error(w, "failed to load league for trade evaluator", http.StatusBadGateway)
+		return
+	}
+
+	if q.Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+		return
+	}
+	if err := templates.ExecuteTemplate(w, "trade_evaluator.html", page); err != nil {
+		log.Printf("[ERROR] Trade evaluator template error: %v", err)
+		http.Error(w, "Error rendering trade evaluator", http.StatusInternalServerError)
+	}
+}
+
+// splitTradeAssets flattens repeated and comma-separated asset parameters
+func splitTradeAssets(values []string) []string {
+	out := []string{}
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
+
+// buildTradeEvaluatorPage fetches the league and evaluates the requested trade, slotting picks by draftOrder
+func buildTradeEvaluatorPage(leagueID, username, draftOrder string, partner int, give, get []string) (*TradeEvaluatorPage, error) {
+	league, err := appProvider.FetchLeague(leagueID)
+	if err != nil || league == nil || league["league_id"] == nil {
+		return nil, fmt.Errorf("league %s not found: %v", leagueID, err)
+	}
+	rosters, err := appProvider.FetchLeagueRosters(leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("rosters: %w", err)
+	}
+	users, _ := appProvider.FetchLeagueUsers(leagueID)
+	players, err := fetchPlayers()
+	if err != nil {
+		return nil, fmt.Errorf("players: %w", err)
+	}
+	userID := ""
+	if username != "" {
+		if user, err := appProvider.FetchUser(username); err == nil && user != nil {
+			userID, _ = user["user_id"].(string)
+		}
+	}
+
+	in := tradeEvaluatorInputs{
+		League:          league,
+		Rosters:         rosters,
+		UserNames:       leagueUserNames(users),
+		UserID:          userID,
+		Players:         players,
+		Tiers:           fetchBorisTiers(leagueScoringFormat(league)),
+		IsSuperFlex:     leagueIsSuperFlex(league),
+		RosterPositions: toStringSlice(league["roster_positions"]),
+	}
+	if isDynastyLeague(league) {
+		in.DynastyValues, _ = fetchDynastyValues()
+		in.PickValues = fetchDynastyPickValues()
+		in.TradedPicks, _ = appProvider.FetchLeagueTradedPicks(leagueID)
+
+		// Slot the upcoming picks the same way the league page does
+		standings := computeStandings(rosters, in.UserNames, userID)
+		if order := upcomingDraftOrder(league, leagueID, draftOrder, rosters, standings); order != nil {
+			in.PickSlots = order.likelySlots()
+		} else {
+			in.PickSlots = pickSlotsFromStandings(standings)
+		}
+	}
+
+	page := buildTradeEvaluator(in, partner, give, get)
+	page.Username = username
+	page.DraftOrder = draftOrder
+	return page, nil
+}
+
+// tradeEvaluatorInputs is everything the evaluator needs, already fetched
+type tradeEvaluatorInputs struct {
+	League          map[string]interface{}
+	Rosters         []map[string]interface{}
+	TradedPicks     []map[string]interface{}
+	UserNames       map[string]string
+	UserID          string
+	Players         map[string]interface{}
+	Tiers           map[string][][]string
+	DynastyValues   map[string]DynastyValue
+	PickValues      map[string]DynastyValue
+	PickSlots       map[int]int // Original roster ID -> projected slot in the upcoming draft
+	IsSuperFlex     bool
+	RosterPositions []string
+}
+
+// errTradePartnerRequired is reported when a trade only gives assets away and names no partner
+const errTradePartnerRequired = "Choose a trade partner: nothing you receive identifies their team."
+
+// buildTradeEvaluator lists both rosters' assets and, when any are requested, evaluates the trade.
+// A partner of 0 is inferred from the requested assets, falling back to the first team by name when
+// nothing is requested. Giving assets away without a partner is an error rather than a guess.
+func buildTradeEvaluator(in tradeEvaluatorInputs, partner int, give, get []string) *TradeEvaluatorPage {
+	page := &TradeEvaluatorPage{IsDynasty: isDynastyLeague(in.League)}
+	page.LeagueID, _ = in.League["league_id"].(string)
+	page.LeagueName, _ = in.League["name"].(string)
+
+	rosterByID := map[int]map[string]interface{}{}
+	userRosterID := 0
+	for _, r := range in.Rosters {
+		rid, _ := r["roster_id"].(float64)
+		owner, _ := r["owner_id"].(string)
+		rosterByID[int(rid)] = r
+		if in.UserID != "" && owner == in.UserID {
+			userRosterID = int(rid)
+			page.YourTeam = rosterTeamName(r, in.UserNames)
+			continue
+		}
+		page.Teams = append(page.Teams, TradeTeamOption{RosterID: int(rid), Name: rosterTeamName(r, in.UserNames)})
+	}
+	sort.SliceStable(page.Teams, func(i, j int) bool { return page.Teams[i].Name < page.Teams[j].Name })
+	if userRosterID == 0 {
+		page.Error = "Add your Sleeper username to evaluate trades in this league."
+		return page
+	}
+
+	var ownership map[string]int
+	var valuer *pickValuer
+	draftYear := upcomingDraftYear(in.League)
+	if draftYear == 0 {
+		draftYear = time.Now().Year()
+	}
+	if page.IsDynasty {
+		ownership = buildPickOwnership(in.TradedPicks, in.Rosters, leagueDraftRounds(in.League), userRosterID, draftYear, nil)
+		valuer = newPickValuer(in.PickValues, in.IsSuperFlex, len(in.Rosters), draftYear)
+	}
+	assetsFor := func(rosterID int) []TradeAsset {
+		return rosterTradeAssets(rosterByID[rosterID], rosterID, in, ownership, valuer)
+	}
+
+	page.YourAssets = assetsFor(userRosterID)
+	if rosterByID[partner] == nil || partner == userRosterID {
+		partner = 0
+		best := 0
+		for _, t := range page.Teams {
+			if _, unmatched := resolveTradeAssets(get, assetsFor(t.RosterID)); len(get)-len(unmatched) > best {
+				partner, best = t.RosterID, len(get)-len(unmatched)
+			}
+		}
+		if partner == 0 && len(give) > 0 && len(page.Teams) > 0 {
+			page.Error = errTradePartnerRequired
+			return page
+		}
+		if partner == 0 && len(page.Teams) > 0 {
+			partner = page.Teams[0].RosterID
+		}
+	}
+	if partner == 0 {
+		page.Error = "There is no other team in this league to trade with."
+		return page
+	}
+	page.PartnerRosterID = partner
+	page.TheirAssets = assetsFor(partner)
+
+	if len(give) == 0 && len(get) == 0 {
+		return page
+	}
+	sends, unmatchedGive := resolveTradeAssets(give, page.YourAssets)
+	receives, unmatchedGet := resolveTradeAssets(get, page.TheirAssets)
+	ev := evaluateTrade(in, userRosterID, partner, sends, receives, ownership, valuer)
+	ev.Unmatched = append(unmatchedGive, unmatchedGet...)
+	page.Evaluation = &ev
+	return page
+}
+
+// rosterTradeAssets lists a roster's players (most valuable first) followed by its picks
+func rosterTradeAssets(r map[string]interface{}, rosterID int, in tradeEvaluatorInputs, ownership map[string]int, valuer *pickValuer) []TradeAsset {
+	assets := []TradeAsset{}
+	for _, pid := range toStringSlice(r["players"]) {
+		p, ok := in.Players[pid].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		a := TradeAsset{ID: pid, Name: getPlayerName(p)}
+		a.Position, _ = p["position"].(string)
+		lookupPos := a.Position
+		if lookupPos == "DEF" {
+			lookupPos = "DST"
+		}
+		a.Tier = findTier(in.Tiers[lookupPos], a.Name)
+		if age, ok := p["age"].(float64); ok {
+			a.Age = int(age)
+		}
+		if in.DynastyValues != nil {
+			a.Value = getDynastyValue(a.Name, in.DynastyValues, in.IsSuperFlex)
+		}
+		assets = append(assets, a)
+	}
+	sort.SliceStable(assets, func(i, j int) bool {
+		if assets[i].Value != assets[j].Value {
+			return assets[i].Value > assets[j].Value
+		}
+		if (assets[i].Tier > 0) != (assets[j].Tier > 0) {
+			return assets[i].Tier > 0
+		}
+		if assets[i].Tier != assets[j].Tier {
+			return assets[i].Tier < assets[j].Tier
+		}
+		return assets[i].Name < assets[j].Name
+	})
+
+	names := map[int]string{}
+	for _, other := range in.Rosters {
+		rid, _ := other["roster_id"].(float64)
+		names[int(rid)] = rosterTeamName(other, in.UserNames)
+	}
+	for _, p := range parseOwnership(ownership) {
+		if p.Owner != rosterID {
+			continue
+		}
+		slot := valuer.slotFor(p.Year, p.OriginalRosterID, in.PickSlots)
+		a := TradeAsset{
+			ID:       fmt.Sprintf("%s%d-%d-%d", tradePickPrefix, p.Year, p.Round, p.OriginalRosterID),
+			Name:     fmt.Sprintf("%d Round %d", p.Year, p.Round),
+			Position: "PICK",
+			Value:    valuer.value(p.Year, p.Round, slot),
+		}
+		if slot > 0 {
+			a.Name = fmt.Sprintf("%d Pick %d.%02d", p.Year, p.Round, slot)
+		}
+		if p.OriginalRosterID != rosterID {
+			a.Name += " (from " + names[p.OriginalRosterID] + ")"
+		}
+		assets = append(assets, a)
+	}
+	return assets
+}
+
+// resolveTradeAssets matches requested assets by ID, player name (exact, then a unique partial match),
+// or pick ("2027 1st", "2027 Pick 1.03"), marking matches Selected. Returns the matches in request
+// order and the requests that matched nothing.
+func resolveTradeAssets(tokens []string, assets []TradeAsset) ([]TradeAsset, []string) {
+	matched := []TradeAsset{}
+	unmatched := []string{}
+	for _, token := range tokens {
+		i := matchTradeAsset(token, assets)
+		if i < 0 {
+			unmatched = append(unmatched, token)
+			continue
+		}
+		assets[i].Selected = true
+		matched = append(matched, assets[i])
+	}
+	return matched, unmatched
+}
+
+// matchTradeAsset returns the index of the first unselected asset the token names, or -1
+func matchTradeAsset(token string, assets []TradeAsset) int {
+	for i, a := range assets {
+		if !a.Selected && a.ID == token {
+			return i
+		}
+	}
+	if m := tradePickToken.FindStringSubmatch(strings.TrimSpace(token)); m != nil {
+		prefix := fmt.Sprintf("%s%s-%s-", tradePickPrefix, m[1], strings.TrimLeft(m[2], "0"))
+		for i, a := range assets {
+			if a.Selected || !strings.HasPrefix(a.ID, prefix) {
+				continue
+			}
+			if slot, _ := strconv.Atoi(m[3]); slot > 0 && !strings.Contains(a.Name, fmt.Sprintf(" %s.%02d", strings.TrimLeft(m[2], "0"), slot)) {
+				continue
+			}
+			return i
+		}
+		return -1
+	}
+	norm := normalizeName(token)
+	if norm == "" {
+		return -1
+	}
+	partial := -1
+	for i, a := range assets {
+		if a.Selected || a.Position == "PICK" {
+			continue
+		}
+		name := normalizeName(a.Name)
+		if name == norm {
+			return i
+		}
+		if strings.Contains(name, norm) {
+			if partial >= 0 {
+				return -1 // Ambiguous
+			}
+			partial = i
+		}
+	}
+	return partial
+}
+
+// evaluateTrade scores the trade for both teams by re-running lineups, the position matrix,
+// ages, and (dynasty) contention windows on the league as it would look afterwards
+func evaluateTrade(in tradeEvaluatorInputs, you, them int, sends, receives []TradeAsset, ownership map[string]int, valuer *pickValuer) TradeEvaluation {
+	ev := TradeEvaluation{IsDynasty: isDynastyLeague(in.League)}
+	ev.LeagueID, _ = in.League["league_id"].(string)
+	ev.LeagueName, _ = in.League["name"].(string)
+
+	moves := map[string]int{}
+	for _, a := range sends {
+		moves[a.ID] = them
+	}
+	for _, a := range receives {
+		moves[a.ID] = you
+	}
+	after := tradedRosters(in.Rosters, moves)
+	afterOwnership := map[string]int{}
+	for key, owner := range ownership {
+		if to, ok := moves[tradePickPrefix+key]; ok {
+			owner = to
+		}
+		afterOwnership[key] = owner
+	}
+
+	slots := starterSlots(in.RosterPositions)
+	before := calculatePositionMatrix(in.Rosters, in.Players, in.Tiers, in.DynastyValues, in.IsSuperFlex, in.RosterPositions, in.UserNames, in.UserID)
+	afterMatrix := calculatePositionMatrix(after, in.Players, in.Tiers, in.DynastyValues, in.IsSuperFlex, in.RosterPositions, in.UserNames, in.UserID)
+	var windowsBefore, windowsAfter map[int]string
+	if ev.IsDynasty {
+		windowsBefore = tradeWindows(in.Rosters, ownership, in, valuer, slots)
+		windowsAfter = tradeWindows(after, afterOwnership, in, valuer, slots)
+	}
+
+	side := func(rosterID int, out, inc []TradeAsset) TradeSideImpact {
+		s := TradeSideImpact{RosterID: rosterID, Sends: out, Receives: inc}
+		var r, ra map[string]interface{}
+		for i := range in.Rosters {
+			if rid, _ := in.Rosters[i]["roster_id"].(float64); int(rid) == rosterID {
+				r, ra = in.Rosters[i], after[i]
+			}
+		}
+		s.TeamName = rosterTeamName(r, in.UserNames)
+		for _, a := range out {
+			s.SendValue += a.Value
+		}
+		for _, a := range inc {
+			s.ReceiveValue += a.Value
+		}
+
+		lineupBefore, tierBefore := bestLineup(activeRosterPlayers(r), in.Players, in.Tiers, slots)
+		lineupAfter, tierAfter := bestLineup(activeRosterPlayers(ra), in.Players, in.Tiers, slots)
+		s.LineupTierBefore = math.Round(tierBefore*100) / 100
+		s.LineupTierAfter = math.Round(tierAfter*100) / 100
+		s.StartersIn = lineupChanges(lineupAfter, lineupBefore)
+		s.StartersOut = lineupChanges(lineupBefore, lineupAfter)
+
+		s.AvgAgeBefore = rosterAverageAge(r, in.Players)
+		s.AvgAgeAfter = rosterAverageAge(ra, in.Players)
+		s.Positions = positionImpacts(before, afterMatrix, rosterID)
+		s.WindowBefore, s.WindowAfter = windowsBefore[rosterID], windowsAfter[rosterID]
+		return s
+	}
+	ev.You = side(you, sends, receives)
+	ev.Them = side(them, receives, sends)
+
+	ev.ValueDelta = ev.You.ReceiveValue - ev.You.SendValue
+	ev.Fairness = calculateTradeFairness(Transaction{
+		Type:           "trade",
+		Team1:          ev.You.TeamName,
+		Team2:          ev.Them.TeamName,
+		Team1GaveValue: ev.You.SendValue,
+		Team2GaveValue: ev.You.ReceiveValue,
+	}, nil)
+	ev.ValueDeltaPct = math.Round(ev.Fairness.ValueDeltaPct*10) / 10
+	ev.Verdict = tradeVerdict(ev)
+
+	give := make([]ProposalPlayer, len(sends))
+	for i, a := range sends {
+		give[i] = a.proposal()
+	}
+	get := make([]ProposalPlayer, len(receives))
+	for i, a := range receives {
+		get[i] = a.proposal()
+	}
+	ev.WinNowImpact = calculateWinNowImpact(give, get, in.DynastyValues)
+	if ev.IsDynasty {
+		ev.FutureImpact = calculateFutureImpact(give, get, in.DynastyValues)
+		ev.WindowFit, ev.WindowNote = tradeWindowFit(ev)
+	}
+	return ev
+}
+
+// tradedRosters copies the rosters with each moved player on his new roster. Players leave IR and
+// taxi when traded; everything else about the rosters is shared with the originals.
+func tradedRosters(rosters []map[string]interface{}, moves map[string]int) []map[string]interface{} {
+	moved := make([]string, 0, len(moves))
+	for id := range moves {
+		if !strings.HasPrefix(id, tradePickPrefix) {
+			moved = append(moved, id)
+		}
+	}
+	sort.Strings(moved)
+
+	out := make([]map[string]interface{}, len(rosters))
+	for i, r := range rosters {
+		rid, _ := r["roster_id"].(float64)
+		copied := make(map[string]interface{}, len(r))
+		for k, v := range r {
+			copied[k] = v
+		}
+		keep := func(key string) []interface{} {
+			ids := []interface{}{}
+			for _, pid := range toStringSlice(r[key]) {
+				if to, ok := moves[pid]; !ok || to == int(rid) {
+					ids = append(ids, pid)
+				}
+			}
+			return ids
+		}
+		players := keep("players")
+		for _, pid := range diff(moved, toStringSlice(r["players"])) {
+			if moves[pid] == int(rid) {
+				players = append(players, pid)
+			}
+		}
+		copied["players"] = players
+		copied["reserve"] = keep("reserve")
+		copied["taxi"] = keep("taxi")
+		out[i] = copied
+	}
+	return out
+}
+
+// activeRosterPlayers lists a roster's players who aren't on IR or taxi
+func activeRosterPlayers(r map[string]interface{}) []string {
+	return diff(toStringSlice(r["players"]), append(toStringSlice(r["reserve"]), toStringSlice(r["taxi"])...))
+}
+
+// lineupChanges names the players in lineup a who aren't in lineup b
+func lineupChanges(a, b []lineupCandidate) []string {
+	inB := map[string]bool{}
+	for _, c := range b {
+		inB[c.ID] = true
+	}
+	names := []string{}
+	for _, c := range a {
+		if !inB[c.ID] {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// rosterAverageAge averages the known ages on a roster, as the team age chart does
+func rosterAverageAge(r map[string]interface{}, players map[string]interface{}) float64 {
+	total, count := 0, 0
+	for _, pid := range toStringSlice(r["players"]) {
+		if p, ok := players[pid].(map[string]interface{}); ok {
+			if age, ok := p["age"].(float64); ok && age > 0 {
+				total += int(age)
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(total)/float64(count)*10) / 10
+}
+
+// positionImpacts compares one roster's position matrix row before and after the trade
+func positionImpacts(before, after PositionMatrix, rosterID int) []TradePositionImpact {
+	row := func(m PositionMatrix) *TeamPositionRow {
+		for i := range m.Teams {
+			if m.Teams[i].RosterID == rosterID {
+				return &m.Teams[i]
+			}
+		}
+		return nil
+	}
+	b, a := row(before), row(after)
+	if b == nil || a == nil {
+		return nil
+	}
+	impacts := make([]TradePositionImpact, len(b.Positions))
+	for i, ps := range b.Positions {
+		impacts[i] = TradePositionImpact{
+			Position:     ps.Position,
+			ValueBefore:  ps.Value,
+			ValueAfter:   a.Positions[i].Value,
+			RankBefore:   ps.Rank,
+			RankAfter:    a.Positions[i].Rank,
+			StatusBefore: ps.Status,
+			StatusAfter:  a.Positions[i].Status,
+		}
+	}
+	return impacts
+}
+
+// tradeWindows classifies every roster's contention window from its lineup, record, age, and picks
+func tradeWindows(rosters []map[string]interface{}, ownership map[string]int, in tradeEvaluatorInputs, valuer *pickValuer, slots []string) map[int]string {
+	capital := pickValueByRoster(ownership, in.PickSlots, valuer)
+	rankings := make([]PowerRanking, 0, len(rosters))
+	for _, r := range rosters {
+		rid, _ := r["roster_id"].(float64)
+		_, tier := bestLineup(activeRosterPlayers(r), in.Players, in.Tiers, slots)
+		rankings = append(rankings, PowerRanking{
+			RosterID:    int(rid),
+			Wins:        int(rosterSettingFloat(r, "wins")),
+			Losses:      int(rosterSettingFloat(r, "losses")),
+			Ties:        int(rosterSettingFloat(r, "ties")),
+			AvgAge:      rosterAverageAge(r, in.Players),
+			StarterTier: tier,
+			PickCapital: capital[int(rid)],
+		})
+	}
+	classifyContentionWindows(rankings)
+	windows := make(map[int]string, len(rankings))
+	for _, r := range rankings {
+		windows[r.RosterID] = r.Window
+	}
+	return windows
+}
+
+// lineupTierGain is how much a side's lineup improves (positive = better tiers); 0 when unranked
+func lineupTierGain(s TradeSideImpact) float64 {
+	if s.LineupTierBefore == 0 || s.LineupTierAfter == 0 {
+		return 0
+	}
+	return s.LineupTierBefore - s.LineupTierAfter
+}
+
+// tradeVerdict reads the value gap from the user's side; without values (redraft) the lineup decides
+func tradeVerdict(ev TradeEvaluation) string {
+	if ev.You.SendValue == 0 && ev.You.ReceiveValue == 0 {
+		switch gain := lineupTierGain(ev.You); {
+		case gain > TRADE_TIER_EPSILON:
+			return "Lineup upgrade"
+		case gain < -TRADE_TIER_EPSILON:
+			return "Lineup downgrade"
+		}
+		return "Even"
+	}
+	if ev.Fairness.Winner == "Fair" || ev.Fairness.ValueDeltaPct < FAIR_THRESHOLD {
+		return "Fair"
+	}
+	if ev.Fairness.Winner == "Team1" {
+		if ev.Fairness.Fleeced {
+			return "Big win"
+		}
+		return "Slight win"
+	}
+	if ev.Fairness.Fleeced {
+		return "Overpay"
+	}
+	return "Slight overpay"
+}
+
+// tradeWindowFit judges the trade against the user's contention window: contenders want a better
+// lineup, rebuilders want youth and future value, retooling teams want value without losing future
+func tradeWindowFit(ev TradeEvaluation) (string, string) {
+	window := ev.You.WindowBefore
+	if window == "" {
+		return "", ""
+	}
+	gain := lineupTierGain(ev.You)
+	ageShift := ev.You.AvgAgeAfter - ev.You.AvgAgeBefore
+	fit, why := TradeNeutralWindow, "it doesn't move your lineup or future much"
+	switch window {
+	case WindowContender, WindowFringe:
+		if gain > TRADE_TIER_EPSILON {
+			fit, why = TradeFitsWindow, "it upgrades your starting lineup"
+		} else if gain < -TRADE_TIER_EPSILON {
+			fit, why = TradeAgainstWindow, "it weakens your starting lineup"
+		}
+	case WindowRebuilding:
+		if ev.FutureImpact > 0 || ageShift < -TRADE_AGE_EPSILON {
+			fit, why = TradeFitsWindow, "it adds youth and future value"
+		} else if ev.FutureImpact < 0 && ageShift > 0 {
+			fit, why = TradeAgainstWindow, "it spends future value on older players"
+		}
+	case WindowRetooling:
+		if ev.ValueDelta > 0 && ev.FutureImpact >= 0 {
+			fit, why = TradeFitsWindow, "it adds value without costing future value"
+		} else if ev.ValueDelta < 0 {
+			fit, why = TradeAgainstWindow, "it gives up value you need to rebuild"
+		}
+	}
+	note := fmt.Sprintf("You're %s and %s", windowPhrase(window), why)
+	if ev.You.WindowAfter != "" && ev.You.WindowAfter != window {
+		note += fmt.Sprintf("; it moves you to %s", windowPhrase(ev.You.WindowAfter))
+	}
+	if isWindowPartner(window, ev.Them.WindowBefore) {
+		note += fmt.Sprintf("; %s is %s, a natural partner", ev.Them.TeamName, windowPhrase(ev.Them.WindowBefore))
+	}
+	return fit, note
+}
+
+// formatTradeEvaluation renders an evaluation as plain text for the CLI
+func formatTradeEvaluation(ev TradeEvaluation) string {
+	var b strings.Builder
+	names := func(assets []TradeAsset) string {
+		out := make([]string, len(assets))
+		for i, a := range assets {
+			out[i] = a.Name
+			if a.Value > 0 {
+				out[i] += fmt.Sprintf(" (%d)", a.Value)
+			}
+		}
+		if len(out) == 0 {
+			return "nothing"
+		}
+		return strings.Join(out, ", ")
+	}
+	fmt.Fprintf(&b, "Trade with %s in %s\n", ev.Them.TeamName, ev.LeagueName)
+	fmt.Fprintf(&b, "  You give: %s\n", names(ev.You.Sends))
+	fmt.Fprintf(&b, "  You get:  %s\n", names(ev.You.Receives))
+	if ev.You.SendValue > 0 || ev.You.ReceiveValue > 0 {
+		fmt.Fprintf(&b, "\nVerdict: %s (%+d value, %.1f%% gap)\n", ev.Verdict, ev.ValueDelta, ev.ValueDeltaPct)
+	} else {
+		fmt.Fprintf(&b, "\nVerdict: %s\n", ev.Verdict)
+	}
+	if ev.IsDynasty {
+		fmt.Fprintf(&b, "Win-now impact: %+d, future impact: %+d\n", ev.WinNowImpact, ev.FutureImpact)
+	}
+	if ev.WindowFit != "" {
+		fmt.Fprintf(&b, "Window fit: %s. %s.\n", ev.WindowFit, ev.WindowNote)
+	}
+	for _, s := range ev.Sides() {
+		fmt.Fprintf(&b, "\n%s:\n", s.TeamName)
+		fmt.Fprintf(&b, "  Lineup tier %.2f -> %.2f, average age %.1f -> %.1f\n", s.LineupTierBefore, s.LineupTierAfter, s.AvgAgeBefore, s.AvgAgeAfter)
+		if len(s.StartersIn) > 0 {
+			fmt.Fprintf(&b, "  Starters in: %s\n", strings.Join(s.StartersIn, ", "))
+		}
+		if len(s.StartersOut) > 0 {
+			fmt.Fprintf(&b, "  Starters out: %s\n", strings.Join(s.StartersOut, ", "))
+		}
+		for _, p := range s.Positions {
+			if p.RankBefore == p.RankAfter && p.ValueBefore == p.ValueAfter {
+				continue
+			}
+			fmt.Fprintf(&b, "  %-3s rank #%d -> #%d", p.Position, p.RankBefore, p.RankAfter)
+			if p.StatusBefore != p.StatusAfter {
+				fmt.Fprintf(&b, " (%s -> %s)", tradeStatusLabel(p.StatusBefore), tradeStatusLabel(p.StatusAfter))
+			}
+			b.WriteString("\n")
+		}
+	}
+	if len(ev.Unmatched) > 0 {
+		fmt.Fprintf(&b, "\nNot found on either roster: %s\n", strings.Join(ev.Unmatched, ", "))
+	}
+	return b.String()
+}
+
+// tradeStatusLabel shows an empty matrix status as "ok"
+func tradeStatusLabel(status string) string {
+	if status == "" {
+		return "ok"
+	}
+	return strings.ToLower(status)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func tradeEvaluatorTestInputs(dynasty bool) tradeEvaluatorInputs {
+	player := func(first, last, pos string, age float64) map[string]interface{} {
+		return map[string]interface{}{"first_name": first, "last_name": last, "position": pos, "age": age}
+	}
+	roster := func(id float64, owner string, wins float64, players, taxi []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"roster_id": id, "owner_id": owner, "players": players, "taxi": taxi,
+			"settings": map[string]interface{}{"wins": wins, "losses": 10 - wins},
+		}
+	}
+	leagueType := float64(0)
+	if dynasty {
+		leagueType = 2
+	}
+	in := tradeEvaluatorInputs{
+		League: map[string]interface{}{
+			"league_id": "L1", "name": "Test League", "season": "2026", "status": "in_season",
+			"settings": map[string]interface{}{"type": leagueType, "draft_rounds": float64(1)},
+		},
+		Rosters: []map[string]interface{}{
+			roster(1, "u1", 6, []interface{}{"p1", "p3", "p7", "p8"}, []interface{}{"p7"}),
+			roster(2, "u2", 8, []interface{}{"p2", "p4"}, nil),
+			roster(3, "u3", 2, []interface{}{"p5", "p6"}, nil),
+		},
+		UserNames: map[string]string{"u1": "Me", "u2": "Partner", "u3": "Other"},
+		UserID:    "u1",
+		Players: map[string]interface{}{
+			"p1": player("Alpha", "Back", "RB", 28),
+			"p2": player("Bravo", "Wide", "WR", 23),
+			"p3": player("Charlie", "Wide", "WR", 25),
+			"p4": player("Delta", "Back", "RB", 24),
+			"p5": player("Echo", "Back", "RB", 30),
+			"p6": player("Foxtrot", "Wide", "WR", 27),
+			"p7": player("Golf", "Back", "RB", 22),
+			"p8": player("Hotel", "Back", "RB", 26),
+		},
+		Tiers: map[string][][]string{
+			"RB": {{"Alpha Back"}, {"Delta Back"}, {"Hotel Back"}, {"Echo Back"}},
+			"WR": {{}, {"Bravo Wide"}, {"Charlie Wide"}, {"Foxtrot Wide"}},
+		},
+		PickSlots:       map[int]int{3: 1, 1: 2, 2: 3},
+		RosterPositions: []string{"RB", "WR", "BN", "BN"},
+	}
+	if dynasty {
+		in.DynastyValues = map[string]DynastyValue{}
+		for name, v := range map[string]int{
+			"Alpha Back": 5000, "Bravo Wide": 6000, "Charlie Wide": 3000, "Delta Back": 4000,
+			"Echo Back": 1000, "Foxtrot Wide": 1500, "Golf Back": 2000, "Hotel Back": 2500,
+		} {
+			in.DynastyValues[normalizeName(name)] = DynastyValue{Name: name, Value1QB: v}
+		}
+	}
+	return in
+}
+
+func TestResolveTradeAssets(t *testing.T) {
+	assets := []TradeAsset{
+		{ID: "1", Name: "Josh Allen", Position: "QB"},
+		{ID: "2", Name: "Josh Jacobs", Position: "RB"},
+		{ID: "pick:2027-1-4", Name: "2027 Pick 1.03", Position: "PICK"},
+		{ID: "pick:2027-1-2", Name: "2027 Round 1 (from Rival)", Position: "PICK"},
+	}
+	matched, unmatched := resolveTradeAssets([]string{"josh", "Allen", "2027 1.3", "2027 round 1", "2027 1st", "2028 2nd", "2"}, assets)
+	names := []string{}
+	for _, a := range matched {
+		names = append(names, a.Name)
+	}
+	if fmt.Sprint(names) != "[Josh Allen 2027 Pick 1.03 2027 Round 1 (from Rival) Josh Jacobs]" {
+		t.Fatalf("unexpected matches: %v", names)
+	}
+	if fmt.Sprint(unmatched) != "[josh 2027 1st 2028 2nd]" {
+		t.Fatalf("expected an ambiguous name and missing picks to go unmatched, got %v", unmatched)
+	}
+	if !assets[0].Selected || !assets[3].Selected {
+		t.Fatalf("expected matches to be marked selected: %+v", assets)
+	}
+}
+
+func TestTradedRosters(t *testing.T) {
+	in := tradeEvaluatorTestInputs(true)
+	after := tradedRosters(in.Rosters, map[string]int{"p7": 2, "p2": 1, "pick:2027-1-1": 2})
+	if got := toStringSlice(after[0]["players"]); fmt.Sprint(got) != "[p1 p3 p8 p2]" {
+		t.Fatalf("unexpected roster 1 after the trade: %v", got)
+	}
+	if got := toStringSlice(after[1]["players"]); fmt.Sprint(got) != "[p4 p7]" {
+		t.Fatalf("unexpected roster 2 after the trade: %v", got)
+	}
+	if len(toStringSlice(after[0]["taxi"])) != 0 || len(toStringSlice(in.Rosters[0]["taxi"])) != 1 {
+		t.Fatalf("expected the traded taxi player to leave taxi without touching the original roster")
+	}
+}
+
+func TestBuildTradeEvaluatorDynasty(t *testing.T) {
+	page := buildTradeEvaluator(tradeEvaluatorTestInputs(true), 0, []string{"Alpha Back"}, []string{"bravo", "2027 1st", "Nobody"})
+	if page.Error != "" || page.YourTeam != "Me" || page.PartnerRosterID != 2 || len(page.Teams) != 2 {
+		t.Fatalf("expected the partner to be inferred from the requested assets: %+v", page)
+	}
+	ev := page.Evaluation
+	if ev == nil {
+		t.Fatalf("expected an evaluation")
+	}
+	pickValue := newPickValuer(nil, false, 3, 2027).value(2027, 1, 3)
+	if ev.You.SendValue != 5000 || ev.You.ReceiveValue != 6000+pickValue || ev.ValueDelta != 1000+pickValue {
+		t.Fatalf("unexpected values: send %d, receive %d, delta %d", ev.You.SendValue, ev.You.ReceiveValue, ev.ValueDelta)
+	}
+	if ev.Verdict != "Big win" || !ev.Fairness.Fleeced || ev.Them.SendValue != ev.You.ReceiveValue {
+		t.Fatalf("unexpected verdict %s: %+v", ev.Verdict, ev.Fairness)
+	}
+	if fmt.Sprint(ev.Unmatched) != "[Nobody]" {
+		t.Fatalf("unexpected unmatched assets: %v", ev.Unmatched)
+	}
+
+	if ev.You.LineupTierBefore != 2 || ev.You.LineupTierAfter != 2.5 {
+		t.Fatalf("unexpected lineup tiers: %.2f -> %.2f", ev.You.LineupTierBefore, ev.You.LineupTierAfter)
+	}
+	if fmt.Sprint(ev.You.StartersIn) != "[Hotel Back Bravo Wide]" || fmt.Sprint(ev.You.StartersOut) != "[Alpha Back Charlie Wide]" {
+		t.Fatalf("unexpected lineup changes: in %v, out %v", ev.You.StartersIn, ev.You.StartersOut)
+	}
+	if ev.You.AvgAgeBefore != 25.3 || ev.You.AvgAgeAfter != 24 {
+		t.Fatalf("unexpected age shift: %.1f -> %.1f", ev.You.AvgAgeBefore, ev.You.AvgAgeAfter)
+	}
+	rb, wr := ev.You.Positions[1], ev.You.Positions[2]
+	if rb.Position != "RB" || rb.ValueBefore != 9500 || rb.ValueAfter != 4500 || wr.ValueBefore != 3000 || wr.ValueAfter != 9000 {
+		t.Fatalf("unexpected positional impact: %+v / %+v", rb, wr)
+	}
+
+	if ev.You.WindowBefore == "" || ev.Them.WindowAfter == "" || ev.WindowFit == "" || ev.WindowNote == "" {
+		t.Fatalf("expected contention windows and a fit verdict: %+v", ev)
+	}
+	if ev.FutureImpact <= 0 {
+		t.Fatalf("expected getting younger and adding a pick to help the future, got %d", ev.FutureImpact)
+	}
+
+	selected := 0
+	for _, a := range page.TheirAssets {
+		if a.Selected {
+			selected++
+		}
+	}
+	if selected != 2 || page.TheirAssets[len(page.TheirAssets)-1].Position != "PICK" {
+		t.Fatalf("expected the partner's assets with two selected and picks last: %+v", page.TheirAssets)
+	}
+	if formatTradeEvaluation(*ev) == "" {
+		t.Fatalf("expected a text summary")
+	}
+}
+
+func TestBuildTradeEvaluatorRedraft(t *testing.T) {
+	page := buildTradeEvaluator(tradeEvaluatorTestInputs(false), 2, []string{"p3"}, []string{"p2"})
+	ev := page.Evaluation
+	if ev == nil || ev.Verdict != "Lineup upgrade" || ev.WindowFit != "" || ev.You.WindowBefore != "" {
+		t.Fatalf("expected a lineup-based verdict without windows: %+v", ev)
+	}
+	for _, a := range page.YourAssets {
+		if a.Position == "PICK" {
+			t.Fatalf("expected no picks in a redraft league")
+		}
+	}
+
+	page = buildTradeEvaluator(tradeEvaluatorInputs{League: map[string]interface{}{"league_id": "L1"}, Rosters: tradeEvaluatorTestInputs(false).Rosters}, 0, nil, nil)
+	if page.Error == "" || page.Evaluation != nil {
+		t.Fatalf("expected an error without the user's roster")
+	}
+}
+
+func TestBuildTradeEvaluatorOneSided(t *testing.T) {
+	ev := buildTradeEvaluator(tradeEvaluatorTestInputs(true), 2, nil, []string{"p4"}).Evaluation
+	if ev == nil || ev.Verdict != "Big win" || !ev.Fairness.Fleeced || ev.ValueDeltaPct != 100 {
+		t.Fatalf("expected getting something for nothing to be a big win: %+v", ev)
+	}
+	ev = buildTradeEvaluator(tradeEvaluatorTestInputs(true), 2, []string{"p8"}, nil).Evaluation
+	if ev == nil || ev.Verdict != "Overpay" || !ev.Fairness.Fleeced || ev.ValueDeltaPct != 100 {
+		t.Fatalf("expected giving a player away to be an overpay: %+v", ev)
+	}
+
+	page := buildTradeEvaluator(tradeEvaluatorTestInputs(true), 0, []string{"p8"}, nil)
+	if page.Error != errTradePartnerRequired || page.Evaluation != nil {
+		t.Fatalf("expected a give-only trade without a partner to be rejected: %+v", page)
+	}
+	if page = buildTradeEvaluator(tradeEvaluatorTestInputs(true), 0, nil, nil); page.Error != "" || page.PartnerRosterID != 3 {
+		t.Fatalf("expected browsing without a partner to open the first team by name: %+v", page)
+	}
+}
+
+func TestTradeEvaluatorHandlerRequiresLeague(t *testing.T) {
+	rec := httptest.NewRecorder()
+	tradeEvaluatorHandler(rec, httptest.NewRequest(http.MethodGet, "/trade-evaluator", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without league_id, got %d", rec.Code)
+	}
+}
@@ -49,11 +49,12 @@ func calculateTradeFairness(transaction Transaction, allRosterValues map[string]
 	}
 
 	// Calculate delta as % of smaller trade side (more generous)
-	// This prevents small trades from being flagged as unfair
+	// This prevents small trades from being flagged as unfair; a side that gave nothing is a 100% gap
 	smallerSide := team1Gave
-	if team2Gave > 0 && team2Gave < team1Gave {
+	if team2Gave < team1Gave {
 		smallerSide = team2Gave
 	}
+	deltaPct = 100
 	if smallerSide > 0 {
 		deltaPct = float64(delta) / float64(smallerSide) * 100
 	}
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestCalculateTradeFairnessOneSided(t *testing.T) {
+	for _, tc := range []struct {
+		team1Gave, team2Gave int
+		winner, winnerTeam   string
+	}{
+		{0, 3000, "Team1", "Alpha"},
+		{3000, 0, "Team2", "Bravo"},
+	} {
+		f := calculateTradeFairness(Transaction{Type: "trade", Team1: "Alpha", Team2: "Bravo", Team1GaveValue: tc.team1Gave, Team2GaveValue: tc.team2Gave}, nil)
+		if f.Winner != tc.winner || f.WinnerTeam != tc.winnerTeam || f.ValueDeltaPct != 100 || !f.Fleeced || f.DisplayBadge != tc.winnerTeam+" +100% 🔴" {
+			t.Fatalf("expected a one-sided trade (%d for %d) to be a 100%% fleece: %+v", tc.team1Gave, tc.team2Gave, f)
+		}
+	}
+
+	if f := calculateTradeFairness(Transaction{Team1: "Alpha", Team2: "Bravo", Team1GaveValue: 1000, Team2GaveValue: 1030}, nil); f.Fleeced || f.ValueDeltaPct != 3 {
+		t.Fatalf("expected a close trade to stay fair: %+v", f)
+	}
+}
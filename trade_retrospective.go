@@ -22,7 +22,7 @@ type TradeSnapshot struct {
 	LeagueID       string
 	Team1          string
 	Team2          string
-	Team1Assets    []string // Player names
+	Team1Assets    []string // Player names, "YYYY Round N" picks, and "$N FAAB"
 	Team2Assets    []string
 	Team1ValueThen int // KTC value at trade time
 	Team2ValueThen int
@@ -31,6 +31,8 @@ type TradeSnapshot struct {
 	Winner         string // "Team1", "Team2", or "Even"
 	ValueSwing     int    // Absolute change in delta
 	DaysElapsed    int
+	PicksValued    bool           // ValueThen includes draft picks (older snapshots counted players only)
+	PickValuesThen map[string]int // Trade-time value of each pick asset, so drafted picks can be left out later
 }
 
 // Cache directory for trade snapshots
@@ -38,7 +40,7 @@ const tradeCacheDir = "/tmp/sleeperpy_trades"
 const retrospectiveSwingThreshold = 200
 
 // Analyze past trades to see who won over time
-func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues map[string]DynastyValue, isSuperFlex bool) []Transaction {
+func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues map[string]DynastyValue, isSuperFlex bool, picks *pickValuer) []Transaction {
 	// Ensure cache directory exists
 	if err := os.MkdirAll(tradeCacheDir, 0755); err != nil {
 		log.Printf("[ERROR] Failed to create trade snapshot cache dir: %v", err)
@@ -67,6 +69,7 @@ func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues
 		snapshotPath := filepath.Join(leagueDir, tradeID+".json")
 
 		var snapshot TradeSnapshot
+		fresh := false // ValueThen was just taken with today's pick values
 		if fileData, err := os.ReadFile(snapshotPath); err == nil {
 			if err := json.Unmarshal(fileData, &snapshot); err != nil {
 				log.Printf("[ERROR] Failed to unmarshal trade snapshot %s: %v", snapshotPath, err)
@@ -84,14 +87,45 @@ func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues
 				Team2Assets:    transactions[i].Team2Gave,
 				Team1ValueThen: transactions[i].Team1GaveValue,
 				Team2ValueThen: transactions[i].Team2GaveValue,
+				PicksValued:    picks != nil,
 			}
+			fresh = true
 		}
+		if !snapshot.PicksValued && picks != nil {
+			// Backfill pick value so "now" (which counts picks) isn't compared to a players-only baseline
+			snapshot.Team1ValueThen += calculateAssetValue(snapshot.Team1Assets, nil, isSuperFlex, picks)
+			snapshot.Team2ValueThen += calculateAssetValue(snapshot.Team2Assets, nil, isSuperFlex, picks)
+			snapshot.PicksValued = true
+			fresh = true
+		}
+		recordPickValuesThen(&snapshot, picks, fresh)
 
 		// Recalculate "now" values every request to evaluate change over time.
-		snapshot.Team1ValueNow = calculateAssetValue(snapshot.Team1Assets, currentDynastyValues, isSuperFlex)
-		snapshot.Team2ValueNow = calculateAssetValue(snapshot.Team2Assets, currentDynastyValues, isSuperFlex)
+		snapshot.Team1ValueNow = calculateAssetValue(snapshot.Team1Assets, currentDynastyValues, isSuperFlex, picks)
+		snapshot.Team2ValueNow = calculateAssetValue(snapshot.Team2Assets, currentDynastyValues, isSuperFlex, picks)
 		snapshot.DaysElapsed = int(time.Since(snapshot.Timestamp).Hours() / 24)
-		snapshot.Winner, snapshot.ValueSwing = calculateRetrospectiveWinner(snapshot)
+
+		// Drafted picks drop out of both sides: "now" has no value for them, so "then" sheds theirs
+		compare := snapshot
+		drafted, known := []string{}, true
+		for _, side := range []struct {
+			assets []string
+			then   *int
+		}{{snapshot.Team1Assets, &compare.Team1ValueThen}, {snapshot.Team2Assets, &compare.Team2ValueThen}} {
+			for _, asset := range side.assets {
+				if !picks.drafted(asset) {
+					continue
+				}
+				drafted = append(drafted, asset)
+				value, ok := snapshot.PickValuesThen[asset]
+				known = known && ok
+				*side.then -= value
+			}
+		}
+		snapshot.Winner, snapshot.ValueSwing = "", 0
+		if known {
+			snapshot.Winner, snapshot.ValueSwing = calculateRetrospectiveWinner(compare)
+		}
 
 		if snapshot.DaysElapsed > 0 && snapshot.Winner != "" {
 			winnerGain := "No significant change"
@@ -104,6 +138,9 @@ func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues
 				DaysElapsed: snapshot.DaysElapsed,
 				WinnerGain:  winnerGain,
 			}
+			if len(drafted) > 0 {
+				transactions[i].Retrospective.DraftedPicks = drafted
+			}
 		}
 
 		data, err := json.Marshal(snapshot)
@@ -119,11 +156,12 @@ func analyzeTradeRetrospective(transactions []Transaction, currentDynastyValues
 	return transactions
 }
 
-func calculateAssetValue(assets []string, dynastyValues map[string]DynastyValue, isSuperFlex bool) int {
+func calculateAssetValue(assets []string, dynastyValues map[string]DynastyValue, isSuperFlex bool, picks *pickValuer) int {
 	total := 0
 	for _, asset := range assets {
-		// Skip draft picks; they are tracked separately and do not map cleanly to player KTC.
-		if strings.Contains(asset, "Round") {
+		// Picks and FAAB don't map to player values; the pick valuer prices them (0 without one)
+		if isPickOrFAABAsset(asset) {
+			total += picks.assetValue(asset)
 			continue
 		}
 		normName := normalizeName(asset)
@@ -138,6 +176,26 @@ func calculateAssetValue(assets []string, dynastyValues map[string]DynastyValue,
 	return total
 }
 
+// recordPickValuesThen keeps the trade-time value of each pick. Drafted picks are only recorded when
+// ValueThen was just taken (they counted as nothing); an older baseline's value for them is unknown.
+func recordPickValuesThen(snapshot *TradeSnapshot, picks *pickValuer, fresh bool) {
+	if picks == nil {
+		return
+	}
+	for _, asset := range append(append([]string{}, snapshot.Team1Assets...), snapshot.Team2Assets...) {
+		if _, _, ok := parsePickAsset(asset); !ok || (picks.drafted(asset) && !fresh) {
+			continue
+		}
+		if _, ok := snapshot.PickValuesThen[asset]; ok {
+			continue
+		}
+		if snapshot.PickValuesThen == nil {
+			snapshot.PickValuesThen = map[string]int{}
+		}
+		snapshot.PickValuesThen[asset] = picks.assetValue(asset)
+	}
+}
+
 func calculateRetrospectiveWinner(snapshot TradeSnapshot) (string, int) {
 	// Positive means Team1 benefited more.
 	advantageThen := snapshot.Team2ValueThen - snapshot.Team1ValueThen
@@ -85,7 +85,7 @@ func TestAnalyzeTradeRetrospectiveCreatesSnapshotAndAttachesResult(t *testing.T)
 		},
 	}
 
-	out := analyzeTradeRetrospective(txns, values, false)
+	out := analyzeTradeRetrospective(txns, values, false, nil)
 	if len(out) != 1 {
 		t.Fatalf("expected 1 transaction, got %d", len(out))
 	}
@@ -100,3 +100,68 @@ func TestAnalyzeTradeRetrospectiveCreatesSnapshotAndAttachesResult(t *testing.T)
 		t.Fatalf("expected >=1 day elapsed, got %d", out[0].Retrospective.DaysElapsed)
 	}
 }
+
+func TestAnalyzeTradeRetrospectiveCountsPicksAndFAAB(t *testing.T) {
+	_ = os.RemoveAll(tradeCacheDir)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(tradeCacheDir)
+	})
+
+	values := map[string]DynastyValue{normalizeName("Player A"): {Value1QB: 3000}}
+	picks := newPickValuer(nil, false, 12, 2026)
+	picks.faabBudget = 100
+	team2Gave := []string{"2026 Round 1", "$50 FAAB"}
+
+	txns := []Transaction{{
+		Type:           "trade",
+		LeagueID:       "league-456",
+		Team1:          "Team One",
+		Team2:          "Team Two",
+		Timestamp:      time.Now().Add(-48 * time.Hour),
+		Team1Gave:      []string{"Player A"},
+		Team2Gave:      team2Gave,
+		Team1GaveValue: 3000,
+		Team2GaveValue: calculateAssetValue(team2Gave, values, false, picks),
+	}}
+	if txns[0].Team2GaveValue != picks.value(2026, 1, 0)+picks.faabValue(50) {
+		t.Fatalf("expected the pick and FAAB to be valued, got %d", txns[0].Team2GaveValue)
+	}
+
+	out := analyzeTradeRetrospective(txns, values, false, picks)
+	if out[0].Retrospective.Winner != "Even" {
+		t.Fatalf("expected unchanged values to stay even, got %+v", out[0].Retrospective)
+	}
+}
+
+func TestAnalyzeTradeRetrospectiveLeavesOutDraftedPicks(t *testing.T) {
+	_ = os.RemoveAll(tradeCacheDir)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(tradeCacheDir)
+	})
+
+	before := newPickValuer(nil, false, 12, 2025)
+	team2Gave := []string{"2025 Round 1"}
+	txns := []Transaction{{
+		Type:           "trade",
+		LeagueID:       "league-789",
+		Team1:          "Team One",
+		Team2:          "Team Two",
+		Timestamp:      time.Now().Add(-48 * time.Hour),
+		Team1Gave:      []string{"Player A"},
+		Team2Gave:      team2Gave,
+		Team1GaveValue: 3000,
+		Team2GaveValue: calculateAssetValue(team2Gave, nil, false, before),
+	}}
+	analyzeTradeRetrospective(txns, map[string]DynastyValue{normalizeName("Player A"): {Value1QB: 3000}}, false, before)
+
+	// After the 2025 draft the pick is a player the snapshot can't follow, so only Player A counts
+	after := newPickValuer(nil, false, 12, 2026)
+	if after.assetValue("2025 Round 1") != 0 {
+		t.Fatalf("expected a drafted pick to have no value")
+	}
+	out := analyzeTradeRetrospective(txns, map[string]DynastyValue{normalizeName("Player A"): {Value1QB: 3500}}, false, after)
+	r := out[0].Retrospective
+	if r.Winner != "Team Two" || r.ValueSwing != 500 || len(r.DraftedPicks) != 1 || r.DraftedPicks[0] != "2025 Round 1" {
+		t.Fatalf("expected the drafted pick left out of the comparison, got %+v", r)
+	}
+}
@@ -0,0 +1,183 @@
+// ABOUTME: Sleeper trending add/drop counts for waiver scoring and hot pickups
+// ABOUTME: Catches breakout players before long-term roster percentages move
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	TRENDING_LOOKBACK_HOURS = 24
+	TRENDING_LIMIT          = 50
+	HOT_PICKUPS_SHOWN       = 10
+)
+
+// fetchTrendingPlayers returns player_id -> count for Sleeper's trending "add" or "drop" list.
+// A failed fetch is cached as nil for failureTTL so an outage isn't retried on every lookup.
+func fetchTrendingPlayers(kind string) map[string]int {
+	sleeperTrendingCache.RLock()
+	if data, ok := sleeperTrendingCache.data[kind]; ok {
+		ttl := sleeperTrendingCache.ttl
+		if data == nil {
+			ttl = sleeperTrendingCache.failureTTL
+		}
+		if time.Since(sleeperTrendingCache.timestamp[kind]) < ttl {
+			sleeperTrendingCache.RUnlock()
+			return data
+		}
+	}
+	sleeperTrendingCache.RUnlock()
+
+	var counts map[string]int
+	entries, err := appProvider.FetchTrendingPlayers(kind, TRENDING_LOOKBACK_HOURS, TRENDING_LIMIT)
+	if err != nil {
+		log.Printf("[ERROR] Error fetching trending %s players: %v", kind, err)
+		totalErrors.Inc()
+	} else {
+		counts = make(map[string]int, len(entries))
+		for _, e := range entries {
+			pid, _ := e["player_id"].(string)
+			count, _ := e["count"].(float64)
+			if pid != "" {
+				counts[pid] = int(count)
+			}
+		}
+		debugLog("[DEBUG] Loaded %d trending %s players", len(counts), kind)
+	}
+
+	sleeperTrendingCache.Lock()
+	sleeperTrendingCache.data[kind] = counts
+	sleeperTrendingCache.timestamp[kind] = time.Now()
+	sleeperTrendingCache.Unlock()
+	return counts
+}
+
+// trendingWaiverBonus scores Sleeper-wide add momentum; heavy dropping counts against a player
+func trendingWaiverBonus(adds, drops int) int {
+	bonus := 0
+	switch {
+	case adds >= 10000:
+		bonus = 20
+	case adds >= 3000:
+		bonus = 12
+	case adds >= 500:
+		bonus = 6
+	}
+	if drops >= 1000 && drops > adds*2 {
+		bonus -= 8
+	}
+	return bonus
+}
+
+// trendSignal describes trending activity, or "" when the player isn't moving
+func trendSignal(adds, drops int) string {
+	switch {
+	case adds >= 500 && adds >= drops:
+		return fmt.Sprintf("trending up (%s adds/24h)", formatCount(adds))
+	case drops >= 1000 && drops > adds*2:
+		return fmt.Sprintf("trending down (%s drops/24h)", formatCount(drops))
+	}
+	return ""
+}
+
+// formatCount abbreviates large counts (12345 -> "12.3k")
+func formatCount(n int) string {
+	if n >= 1000 {
+		return fmt.Sprintf("%.1fk", float64(n)/1000)
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// buildHotPickups merges trending adds with the league's own repeat waiver claims.
+// League-only claims are matched back to Sleeper players by name for tier, team, and availability.
+func buildHotPickups(adds, drops map[string]int, leagueHot []WaiverActivity, players map[string]interface{}, rostered map[string]bool, tiers map[string][][]string) []HotPickup {
+	byName := make(map[string]*HotPickup)
+	order := []string{}
+	add := func(name string, p HotPickup) *HotPickup {
+		key := normalizeName(name)
+		if existing, ok := byName[key]; ok {
+			return existing
+		}
+		byName[key] = &p
+		order = append(order, key)
+		return byName[key]
+	}
+	describe := func(pid string, p map[string]interface{}) HotPickup {
+		pos, _ := p["position"].(string)
+		team, _ := p["team"].(string)
+		name := getPlayerName(p)
+		lookupPos := pos
+		if lookupPos == "DEF" {
+			lookupPos = "DST"
+		}
+		return HotPickup{PlayerName: name, Position: pos, Team: team, Tier: findTier(tiers[lookupPos], name), Available: !rostered[pid]}
+	}
+
+	for pid, count := range adds {
+		p, ok := players[pid].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		hp := add(getPlayerName(p), describe(pid, p))
+		hp.TrendingAdds = count
+		hp.TrendingDrops = drops[pid]
+	}
+
+	// Find the Sleeper player behind each league-only claim, preferring a position match
+	unmatched := make(map[string]string) // normalized name -> claimed position
+	for _, w := range leagueHot {
+		if key := normalizeName(w.PlayerName); byName[key] == nil {
+			unmatched[key] = w.Position
+		}
+	}
+	claimed := make(map[string]string) // normalized name -> player ID
+	if len(unmatched) > 0 {
+		for pid, raw := range players {
+			p, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key := normalizeName(getPlayerName(p))
+			pos, seen := unmatched[key]
+			if !seen {
+				continue
+			}
+			if existing, ok := claimed[key]; ok {
+				if prev, _ := players[existing].(map[string]interface{}); prev != nil && prev["position"] == pos {
+					continue
+				}
+			}
+			claimed[key] = pid
+		}
+	}
+	for _, w := range leagueHot {
+		hp := HotPickup{PlayerName: w.PlayerName, Position: w.Position}
+		if pid, ok := claimed[normalizeName(w.PlayerName)]; ok {
+			hp = describe(pid, players[pid].(map[string]interface{}))
+		}
+		add(w.PlayerName, hp).LeagueClaims = w.ClaimCount
+	}
+
+	pickups := make([]HotPickup, 0, len(order))
+	for _, key := range order {
+		pickups = append(pickups, *byName[key])
+	}
+	// A repeat claim in this league signals more than a few thousand adds elsewhere
+	heat := func(h HotPickup) int {
+		return h.TrendingAdds + h.LeagueClaims*2000
+	}
+	sort.SliceStable(pickups, func(i, j int) bool {
+		if heat(pickups[i]) != heat(pickups[j]) {
+			return heat(pickups[i]) > heat(pickups[j])
+		}
+		return pickups[i].PlayerName < pickups[j].PlayerName
+	})
+	if len(pickups) > HOT_PICKUPS_SHOWN {
+		pickups = pickups[:HOT_PICKUPS_SHOWN]
+	}
+	return pickups
+}
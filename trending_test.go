@@ -0,0 +1,146 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTrendingWaiverBonus(t *testing.T) {
+	if got := trendingWaiverBonus(12000, 50); got != 20 {
+		t.Fatalf("expected top bonus for heavy adds, got %d", got)
+	}
+	if got := trendingWaiverBonus(600, 0); got != 6 {
+		t.Fatalf("expected small bonus, got %d", got)
+	}
+	if got := trendingWaiverBonus(100, 5000); got >= 0 {
+		t.Fatalf("expected penalty for heavy drops, got %d", got)
+	}
+	if got := trendSignal(12345, 10); got != "trending up (12.3k adds/24h)" {
+		t.Fatalf("unexpected trend signal: %q", got)
+	}
+	if got := trendSignal(10, 10); got != "" {
+		t.Fatalf("expected no signal for quiet player, got %q", got)
+	}
+}
+
+func TestScoreWaiverTargetUsesTrending(t *testing.T) {
+	league := LeagueData{HasMatchups: true}
+	quiet := PlayerRow{Name: "Quiet", Pos: "WR", Tier: 6, RosterPercent: 10}
+	hot := quiet
+	hot.Name = "Hot"
+	hot.TrendingAdds = 15000
+
+	quietRec := scoreWaiverTarget(quiet, league, "WR", 20)
+	hotRec := scoreWaiverTarget(hot, league, "WR", 20)
+	if hotRec.Score <= quietRec.Score {
+		t.Fatalf("expected trending player to score higher: %d vs %d", hotRec.Score, quietRec.Score)
+	}
+	if hotRec.UsageSignal != "trending up (15.0k adds/24h), low usage signal" {
+		t.Fatalf("unexpected usage signal: %q", hotRec.UsageSignal)
+	}
+}
+
+func TestBuildHotPickups(t *testing.T) {
+	players := map[string]interface{}{
+		"p1": map[string]interface{}{"first_name": "Breakout", "last_name": "Back", "position": "RB", "team": "DET"},
+		"p2": map[string]interface{}{"first_name": "Rostered", "last_name": "Receiver", "position": "WR", "team": "KC"},
+		"p3": map[string]interface{}{"first_name": "League", "last_name": "Only", "position": "TE", "team": "NYJ"},
+		"p4": map[string]interface{}{"first_name": "League", "last_name": "Only", "position": "LB", "team": "SF"},
+	}
+	adds := map[string]int{"p1": 20000, "p2": 800}
+	drops := map[string]int{"p1": 100}
+	leagueHot := []WaiverActivity{
+		{PlayerName: "Rostered Receiver", Position: "WR", ClaimCount: 3},
+		{PlayerName: "League Only", Position: "TE", ClaimCount: 2},
+	}
+	tiers := map[string][][]string{"RB": {{"Breakout Back"}}, "TE": {{}, {}, {"League Only"}}}
+
+	pickups := buildHotPickups(adds, drops, leagueHot, players, map[string]bool{"p2": true}, tiers)
+	if len(pickups) != 3 {
+		t.Fatalf("expected merged pickups, got %+v", pickups)
+	}
+	first := pickups[0]
+	if first.PlayerName != "Breakout Back" || !first.Available || first.Tier != 1 || first.TrendingDrops != 100 {
+		t.Fatalf("unexpected top pickup: %+v", first)
+	}
+	if pickups[1].PlayerName != "Rostered Receiver" || pickups[1].LeagueClaims != 3 || pickups[1].Available {
+		t.Fatalf("expected merged league claims for rostered WR: %+v", pickups[1])
+	}
+	if leagueOnly := pickups[2]; !leagueOnly.Available || leagueOnly.Tier != 3 || leagueOnly.Team != "NYJ" || leagueOnly.LeagueClaims != 2 {
+		t.Fatalf("expected the league-only claim matched to the free-agent TE: %+v", leagueOnly)
+	}
+}
+
+func TestFetchTrendingPlayersCaches(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("lookback_hours") != "24" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"player_id":"p1","count":321}]`))
+	}))
+	defer server.Close()
+
+	p := NewSleeperProvider(server.Client())
+	p.baseURL = server.URL
+	saved := appProvider
+	appProvider = p
+	defer func() { appProvider = saved }()
+
+	sleeperTrendingCache.Lock()
+	delete(sleeperTrendingCache.data, "add")
+	sleeperTrendingCache.Unlock()
+	defer func() {
+		sleeperTrendingCache.Lock()
+		delete(sleeperTrendingCache.data, "add")
+		sleeperTrendingCache.timestamp["add"] = time.Time{}
+		sleeperTrendingCache.Unlock()
+	}()
+
+	first := fetchTrendingPlayers("add")
+	second := fetchTrendingPlayers("add")
+	if first["p1"] != 321 || second["p1"] != 321 {
+		t.Fatalf("unexpected trending counts: %v %v", first, second)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected cached second call, got %d requests", n)
+	}
+}
+
+func TestFetchTrendingPlayersCachesFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	p := NewSleeperProvider(server.Client())
+	p.baseURL = server.URL
+	saved := appProvider
+	appProvider = p
+	defer func() { appProvider = saved }()
+
+	sleeperTrendingCache.Lock()
+	delete(sleeperTrendingCache.data, "drop")
+	sleeperTrendingCache.Unlock()
+	defer func() {
+		sleeperTrendingCache.Lock()
+		delete(sleeperTrendingCache.data, "drop")
+		sleeperTrendingCache.timestamp["drop"] = time.Time{}
+		sleeperTrendingCache.Unlock()
+	}()
+
+	if counts := fetchTrendingPlayers("drop"); counts != nil {
+		t.Fatalf("expected no counts from a failed fetch, got %v", counts)
+	}
+	fetchTrendingPlayers("drop")
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected the failure to be cached, got %d requests", n)
+	}
+}
@@ -20,20 +20,40 @@ type tiersCache struct {
 type DynastyValue struct {
 	Name       string
 	Position   string
+	Team       string
+	DraftYear  int // NFL draft year, used to pick out rookie classes
 	Value1QB   int
 	Value2QB   int
 	ScrapeDate string
 }
 
-// Cache for dynasty values
+// Cache for dynasty values (nil data after a failed fetch, for caches with a failureTTL)
 type dynastyCache struct {
 	sync.RWMutex
-	data      map[string]DynastyValue // key: normalized player name
-	timestamp time.Time
-	ttl       time.Duration
+	data       map[string]DynastyValue // key: normalized player name
+	timestamp  time.Time
+	ttl        time.Duration
+	failureTTL time.Duration
+}
+
+// Cache for NFL bye weeks by season (key: season, value: team -> bye week; nil after a failed fetch)
+type byeWeeksCache struct {
+	sync.RWMutex
+	data       map[string]map[string]int
+	timestamp  map[string]time.Time
+	ttl        time.Duration
+	failureTTL time.Duration
+}
+
+// Cache for Sleeper trending players (key: "add" or "drop", value: player_id -> count; nil after a failed fetch)
+type trendingCache struct {
+	sync.RWMutex
+	data       map[string]map[string]int
+	timestamp  map[string]time.Time
+	ttl        time.Duration
+	failureTTL time.Duration
 }
 
-// Cache for Sleeper players API response
 type playersCache struct {
 	sync.RWMutex
 	data      map[string]interface{}
@@ -41,7 +61,16 @@ type playersCache struct {
 	ttl       time.Duration
 }
 
+// Cache for weekly league payloads such as matchups and transactions (key: league_id:week)
+type matchupsCache struct {
+	sync.RWMutex
+	data      map[string][]map[string]interface{}
+	timestamp map[string]time.Time
+	ttl       time.Duration
+}
+
 type PlayerRow struct {
+	PlayerID             string
 	Pos                  string
 	Name                 string
 	Tier                 interface{}
@@ -55,17 +84,31 @@ type PlayerRow struct {
 	IsSuperflex          bool   // Heuristic SUPERFLEX indicator
 	DynastyValue         int    // Dynasty value from DynastyProcess (0-10000 scale)
 	Age                  int    // Player age from Sleeper API
+	YearsExp             int    // NFL seasons played (0 = rookie)
+	InjuryStatus         string // "Questionable", "Out", "IR", etc. from Sleeper API
 	RosterPercent        float64
+	RealPos              string // Player's own position (Pos may be a lineup slot or "?")
+	Team                 string // NFL team abbreviation
+	ByeWeek              int
+	IsIR                 bool // Stashed in an IR slot
+	TrendingAdds         int  // Sleeper-wide adds over the trending lookback
+	TrendingDrops        int
+	ValueProjection      []int // Age-curve dynasty value 1, 2 and 3 seasons out
 }
 
 type TeamAgeData struct {
 	TeamName    string
 	OwnerName   string
 	AvgAge      float64
-	Rank        int // Standings rank (wins)
+	Rank        int // Standings rank (1 = best)
+	Wins        int
+	Losses      int
+	Ties        int
+	PointsFor   float64
 	RosterID    int
 	IsUserTeam  bool
-	RosterValue int // Total dynasty value of roster
+	RosterValue int    // Total dynasty value of roster
+	Window      string // Contention window from the power rankings
 }
 
 type PowerRanking struct {
@@ -75,10 +118,23 @@ type PowerRanking struct {
 	Wins         int
 	Losses       int
 	AvgAge       float64
-	Strategy     string // "Win Now", "Contending", "Rebuilding"
+	Window       string // Contention window: Contender, Fringe, Retooling, Rebuilding
+	WindowReason string // Why the team landed in its window
 	IsUserTeam   bool
 	ValueRank    int // Rank by dynasty value
-	StandingRank int // Rank by wins
+	StandingRank int // Rank in league standings
+	Ties         int
+	PointsFor    float64
+	RosterID     int
+	AllPlayRank  int     // 0 when no completed weeks
+	AllPlayPct   float64 // All-play win rate (0-1)
+	Luck         float64 // Actual minus expected wins
+	StarterTier  float64 // Average tier of the best lineup
+	PickCapital  float64 // Value of draft picks owned
+	TrendPct     float64 // Recent scoring vs season average
+	Score        float64 // 0-100 weighted power score
+	PrevRank     int     // Rank before the latest completed week (0 = none)
+	Movement     int     // Positive = moved up
 }
 
 type DraftPick struct {
@@ -88,18 +144,27 @@ type DraftPick struct {
 	OriginalName string // Original owner if traded, empty if not traded
 	RosterID     int
 	IsYours      bool
+	Value        int // Pick value on the dynasty value scale
 }
 
 type ProjectedDraftPick struct {
 	Year              int
 	Round             int
 	OverallPick       int    // Projected overall pick number (e.g., 1.01 = 1, 1.12 = 12, 2.01 = 13)
-	ProjectedPosition int    // Projected position within round (1-12)
+	ProjectedPosition int    // Most likely position within round (1 to league size)
 	OwnerName         string // Team that currently owns this pick
 	OriginalOwner     string // Original owner if traded
 	CurrentStanding   int    // Current standing of the team (1 = worst record, 12 = best)
 	TeamRecord        string // e.g., "3-11"
 	IsYours           bool
+	Value             int               // Pick value, weighted across the slot distribution
+	SlotOdds          []SlotProbability // Chance of each slot under the league's draft order method
+}
+
+// SlotProbability is the chance a pick lands in one draft slot
+type SlotProbability struct {
+	Slot int
+	Pct  float64
 }
 
 type PositionalKTC struct {
@@ -109,6 +174,35 @@ type PositionalKTC struct {
 	TE int
 }
 
+// PositionStrength is one team's standing at one position in the league matrix
+type PositionStrength struct {
+	Position     string
+	StarterDepth int     // Active players inside the league's starter pool at this position
+	Needed       int     // Starters the lineup requires here (flex spots shared out)
+	StarterTier  float64 // Average tier of the best active players filling the needed spots
+	Value        int     // Dynasty value at the position (dynasty leagues only)
+	Score        float64 // 0-100 blend of starter depth, starter tier, and value
+	Rank         int     // 1 = strongest in the league
+	Status       string  // "Surplus", "Need", or ""
+	TopPlayer    string
+}
+
+// TeamPositionRow is one team's row of the position matrix
+type TeamPositionRow struct {
+	RosterID   int
+	TeamName   string
+	IsUserTeam bool
+	Positions  []PositionStrength // Same order as PositionMatrix.Positions
+	Surplus    []string
+	Needs      []string
+}
+
+// PositionMatrix rates every team at QB/RB/WR/TE
+type PositionMatrix struct {
+	Positions []string
+	Teams     []TeamPositionRow
+}
+
 type TradeTarget struct {
 	TeamName        string
 	Reason          string
@@ -116,6 +210,7 @@ type TradeTarget struct {
 	TheirSurplus    string
 	YourSurplusKTC  int
 	TheirSurplusKTC int
+	TheirWindow     string         // Their contention window
 	Proposal        *TradeProposal // Feature #9: Trade coach proposal
 }
 
@@ -137,6 +232,14 @@ type ProposalPlayer struct {
 	Position     string
 	DynastyValue int
 	Tier         string
+	Age          int // 0 for picks and unknown ages
+}
+
+// BuyLowTarget is another team's player the age curve expects to gain value
+type BuyLowTarget struct {
+	Player           PlayerRow
+	TeamName         string
+	ProjectedGainPct float64 // Two-season projected change
 }
 
 type PlayerNews struct {
@@ -189,10 +292,11 @@ type TradeFairness struct {
 }
 
 type TradeRetrospective struct {
-	Winner      string // Who won the trade overall
-	ValueSwing  int    // How much value swung
-	DaysElapsed int    // Days since trade
-	WinnerGain  string // "+500 value" or similar
+	Winner       string   // Who won the trade overall
+	ValueSwing   int      // How much value swung
+	DaysElapsed  int      // Days since trade
+	WinnerGain   string   // "+500 value" or similar
+	DraftedPicks []string // Picks since drafted, left out of the comparison
 }
 
 type Transaction struct {
@@ -216,12 +320,15 @@ type Transaction struct {
 }
 
 type RookieProspect struct {
-	Name     string
-	Position string
-	College  string
-	Value    int
-	Rank     int
-	Year     int // Draft year
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	College  string `json:"college,omitempty"`
+	Team     string `json:"team,omitempty"` // NFL team once drafted
+	Value    int    `json:"value"`
+	Rank     int    `json:"rank"`
+	Year     int    `json:"year"`                // Draft year
+	PlayerID string `json:"player_id,omitempty"` // Sleeper ID once the player is in Sleeper's database
+	Source   string `json:"-"`                   // "DynastyProcess" or "prospect file"
 }
 
 type LeagueTrends struct {
@@ -247,22 +354,90 @@ type WaiverActivity struct {
 	LastClaimed string // Time ago
 }
 
+// HotPickup merges Sleeper-wide trending adds with this league's waiver claims
+type HotPickup struct {
+	PlayerName    string
+	Position      string
+	Team          string
+	Tier          int
+	TrendingAdds  int
+	TrendingDrops int
+	LeagueClaims  int
+	Available     bool // Not rostered in this league
+}
+
 type Action struct {
 	Priority    int    // 1-5 (1 = highest)
-	Category    string // "swap", "waiver", "trade", "injury", "lineup"
+	Category    string // "swap", "waiver", "trade", "injury", "lineup", "taxi"
 	Title       string // "Swap Starter"
 	Description string // "Start Jahmyr Gibbs over James Conner"
 	Impact      string // "+1.2 tier upgrade"
 	Link        string // "#player-name" anchor link
+	PlayerName  string // Player the action favors (start or add)
+	VersusName  string // Player it displaces (benched starter or drop)
 	Completed   bool   // User checked it off
 	WeekID      string // "2026-W14" for persistence
 }
 
+// Opponent scouting types
+type OpponentReport struct {
+	TeamName      string
+	Starters      []PlayerRow
+	Bench         []PlayerRow
+	Injuries      []PlayerRow
+	PositionEdges []PositionEdge
+	RecentMoves   []Transaction
+	HeadToHead    HeadToHeadRecord
+}
+
+type PositionEdge struct {
+	Position     string
+	YourAvgTier  float64
+	TheirAvgTier float64
+	Edge         string // "You", "Them", "Even"
+}
+
+type HeadToHeadRecord struct {
+	Wins   int
+	Losses int
+	Ties   int
+	Games  []HeadToHeadGame
+}
+
+type HeadToHeadGame struct {
+	Week        int
+	YourPoints  float64
+	TheirPoints float64
+	Result      string // "W", "L", "T"
+}
+
+// Taxi squad types
+type TaxiSettings struct {
+	Slots     int  // taxi_slots
+	Years     int  // taxi_years: players with fewer NFL seasons are eligible
+	AllowVets bool // taxi_allow_vets
+}
+
+type TaxiSuggestion struct {
+	Type        string // "promote", "demote", "expiring"
+	PlayerName  string
+	Position    string
+	Counterpart string // Roster player involved in the move (if any)
+	Reason      string
+}
+
 type WaiverRecommendation struct {
 	Player           PlayerRow
 	Score            int
 	Priority         string
-	SuggestedBid     int
+	SuggestedBid     int    // Percent of budget
+	BidDollars       int    // FAAB leagues: dollars from the user's remaining budget
+	BidAdvice        string // Rolling/reverse leagues: whether to spend waiver priority
+	Market           FAABEstimate
+	HasMarket        bool // Bid priced from league history
+	NeedsDrop        bool
+	Drop             DropCandidate
+	RosterWarning    string // Set when no drop keeps the roster legal
 	Rationale        string
 	ImpactType       string
 	Role             string
@@ -271,6 +446,74 @@ type WaiverRecommendation struct {
 	PositionScarcity int
 }
 
+// WaiverBudget describes the league's waiver system and remaining FAAB
+type WaiverBudget struct {
+	WaiverType           int // 0 = rolling, 1 = reverse standings, 2 = FAAB
+	IsFAAB               bool
+	Budget               int
+	UserRemaining        int
+	AvgOpponentRemaining int
+	MaxOpponentRemaining int
+	UserPriority         int // Waiver position (1 = first)
+	Teams                int
+	Week                 int
+	WeeksRemaining       int // Regular-season weeks left
+	RegularSeasonWeeks   int
+}
+
+// FAABBid is a winning waiver claim from league history
+type FAABBid struct {
+	Season     string
+	Week       int
+	PlayerName string
+	Position   string
+	Tier       int // Current tier (0 for prior seasons or unranked)
+	Amount     int
+	BidPct     float64 // Percent of that season's budget
+}
+
+// FAABMarket is the league's learned FAAB price model
+type FAABMarket struct {
+	Bids          []FAABBid
+	CurrentSeason string
+	Seasons       int
+	Positions     []FAABPositionPrice
+}
+
+// FAABPositionPrice summarizes winning bids at one position
+type FAABPositionPrice struct {
+	Position  string
+	Samples   int
+	MedianPct float64
+	TopPct    float64
+}
+
+// FAABEstimate is a market-based bid suggestion in dollars
+type FAABEstimate struct {
+	Bid            int
+	Low            int
+	High           int
+	WinProbability float64 // Percent of comparable winning bids at or below Bid
+	Samples        int
+}
+
+// RosterStatus tracks roster capacity and legality for add/drop advice
+type RosterStatus struct {
+	ActiveSlots   int // Roster spots excluding IR and taxi
+	ActivePlayers int
+	OpenSpots     int
+	StarterSlots  []string
+	Week          int
+	Warnings      []string
+}
+
+// DropCandidate is a rostered player paired with an add
+type DropCandidate struct {
+	Player    PlayerRow
+	KeepScore float64 // Lower is more droppable
+	Reason    string
+}
+
 type SeasonPlan struct {
 	CurrentPhase       string
 	PhaseDescription   string
@@ -282,6 +525,96 @@ type SeasonPlan struct {
 	TradeWindow        string
 	NextMilestone      string
 	WeeksRemaining     int
+	WeeklyOutlook      []ScheduleWeek // Remaining regular-season opponents
+}
+
+// ScheduleWeek rates one remaining regular-season opponent
+type ScheduleWeek struct {
+	Week           int
+	Opponent       string
+	OpponentRating float64 // Team strength rating (0-100)
+	OpponentRank   int     // Strength rank within the league (1 = strongest)
+	Difficulty     string  // "Hard", "Moderate", "Easy"
+}
+
+// PlayoffOdds is one team's simulated postseason outlook (percentages 0-100)
+type PlayoffOdds struct {
+	RosterID        int
+	TeamName        string
+	Record          string
+	PlayoffPct      float64
+	ByePct          float64
+	ChampionshipPct float64
+	IsUserTeam      bool
+}
+
+// AllPlayRecord is a team's record against every other team, week by week
+type AllPlayRecord struct {
+	RosterID     int
+	TeamName     string
+	Rank         int // All-play rank (1 = best)
+	Wins         int
+	Losses       int
+	Ties         int
+	WinPct       float64
+	Games        int // Head-to-head games played
+	ActualWins   int
+	ActualLosses int
+	ExpectedWins float64
+	Luck         float64 // Actual minus expected wins (positive = lucky)
+	IsUserTeam   bool
+}
+
+// LineupAudit compares started lineups with the best lineups in hindsight
+type LineupAudit struct {
+	Managers       []ManagerEfficiency // Every team, most efficient first
+	User           ManagerEfficiency
+	UserWeeks      []LineupWeek
+	WorstDecisions []LineupDecision // User's costliest start/sit calls
+}
+
+// ManagerEfficiency is a team's season-long lineup efficiency
+type ManagerEfficiency struct {
+	RosterID      int
+	TeamName      string
+	Rank          int
+	Weeks         int
+	ActualPoints  float64
+	OptimalPoints float64
+	PointsLeft    float64
+	Efficiency    float64 // Actual as a percent of optimal
+	IsUserTeam    bool
+}
+
+// LineupWeek is one week of the user's lineup audit
+type LineupWeek struct {
+	Week       int
+	Actual     float64
+	Optimal    float64
+	PointsLeft float64
+}
+
+// LineupDecision is a benched player who should have started over a starter
+type LineupDecision struct {
+	Week          int
+	Started       string
+	StartedPos    string
+	StartedPoints float64
+	Benched       string
+	BenchedPos    string
+	BenchedPoints float64
+	PointsLost    float64
+}
+
+// TeamStrength rates a roster from its best lineup, scoring, and value
+type TeamStrength struct {
+	RosterID    int
+	TeamName    string
+	StarterTier float64 // Average tier of the optimal starting lineup
+	RosterValue int
+	PointsFor   float64
+	GamesPlayed int
+	Rating      float64 // 0-100 blended strength
 }
 
 type KeyDate struct {
@@ -311,6 +644,7 @@ type RookieDraftNeed struct {
 }
 
 type LeagueData struct {
+	LeagueID              string
 	LeagueName            string
 	Season                string
 	Scoring               string
@@ -326,22 +660,39 @@ type LeagueData struct {
 	WinProb               string
 	Bench                 []PlayerRow
 	BenchUnranked         []PlayerRow
+	Taxi                  []PlayerRow // Taxi squad (dynasty) - excluded from bench and lineup logic
+	TaxiUnranked          []PlayerRow
+	TaxiSuggestions       []TaxiSuggestion
+	OpponentReport        OpponentReport
+	TeamStrengths         []TeamStrength // Every team, strongest first
+	ScheduleOutlook       []ScheduleWeek // User's remaining regular-season schedule
+	PlayoffOdds           []PlayoffOdds  // Simulated playoff/bye/title odds for every team
+	AllPlayRecords        []AllPlayRecord
+	LineupAudit           LineupAudit
 	FreeAgentsByPos       map[string][]PlayerRow
 	TopFreeAgents         []PlayerRow
 	TopFreeAgentsByValue  []PlayerRow
-	TotalRosterValue      int
+	TotalRosterValue      int // Players plus draft picks
+	DraftPickValue        int // User's draft pick value included in TotalRosterValue
 	UserAvgAge            float64
 	TeamAges              []TeamAgeData
 	PowerRankings         []PowerRanking
+	TradePartners         []PowerRanking // Teams whose contention window complements the user's
 	DraftPicks            []DraftPick
 	ProjectedDraftPicks   []ProjectedDraftPick
+	DraftOrderMethod      string // How projected picks are ordered (e.g. "reverse standings")
+	DraftOrder            string // Draft order method chosen with draft_order_<league_id>
 	TradeTargets          []TradeTarget
 	PositionalBreakdown   PositionalKTC
+	PositionMatrix        PositionMatrix
 	PlayerNewsFeed        []PlayerNews
 	BreakoutCandidates    []PlayerRow
 	AgingPlayers          []PlayerRow
+	BuyLowTargets         []BuyLowTarget
+	ProjectedRosterValue  []int // User's player value 1, 2 and 3 seasons out (age curve)
 	RecentTransactions    []Transaction
 	TopRookies            []RookieProspect
+	RookieDraftYear       int // Class TopRookies covers
 	LeagueTrends          LeagueTrends
 	PremiumTeamTalk       string
 	WeeklyActions         []Action               // Feature #2: Weekly Action List
@@ -349,6 +700,10 @@ type LeagueData struct {
 	ContextCards          []ContextCard          // Feature #6: League Context Cards
 	ValueChanges          []ValueChange          // Feature #7: Value Change Tracker
 	WaiverRecommendations []WaiverRecommendation // Feature #10: Advanced Waiver Model
+	WaiverBudget          WaiverBudget           // Waiver type and remaining FAAB
+	FAABMarket            FAABMarket             // Winning bid prices from league history
+	RosterStatus          RosterStatus           // Roster capacity and legality for add/drop advice
+	HotPickups            []HotPickup            // Trending adds merged with league waiver activity
 	SeasonPlan            SeasonPlan             // Feature #11: Season Planner
 	DraftStrategy         DraftStrategy          // Feature #12: Rookie Draft Needs
 }
@@ -381,13 +736,22 @@ type LeagueSummary struct {
 	TotalRosterValue  int
 	ValueRank         int    // 1-12
 	ValueTrend        string // "↗ +5%", "↘ -3%", "→ stable"
+	ValueDeltas       []ValueDelta
+	ValueHistory      []ValueHistoryPoint
+	ValueChart        ValueChart
 	AvgAge            float64
 	AgeRank           int
 	DraftPicksSummary string // "2026 1st, 2027 1st, 2nd"
 
 	// Season metrics
 	Record        string // "8-5" or empty if offseason
-	PlayoffStatus string // "Clinched", "In Hunt", "Eliminated", ""
+	PlayoffStatus string // "Near Lock (>99%)", "In Hunt (N%)", "Long Shot (<1%)", ""
+
+	// Simulated postseason odds (in-season only)
+	HasPlayoffOdds  bool
+	PlayoffPct      float64
+	ByePct          float64
+	ChampionshipPct float64
 
 	// Action items (for Feature #2)
 	ActionCount int
@@ -403,14 +767,96 @@ type DashboardPage struct {
 	RedraftCount    int
 }
 
-// Cache for roster value trends (24h comparison)
-type CachedRosterValue struct {
-	RosterValue int
-	Timestamp   time.Time
-}
-
-type valueTrendCache struct {
-	sync.RWMutex
-	data map[string]CachedRosterValue // key: "username:leagueID"
-	ttl  time.Duration
+// RosterValueDay is every team's total dynasty value and value rank on one date
+type RosterValueDay struct {
+	Date   string      `json:"date"`   // YYYY-MM-DD
+	Values map[int]int `json:"values"` // roster ID -> total dynasty value
+	Ranks  map[int]int `json:"ranks"`  // roster ID -> league value rank (1 = highest)
+}
+
+// RosterValueHistory is a league's persisted daily value series
+type RosterValueHistory struct {
+	LeagueID string           `json:"league_id"`
+	Days     []RosterValueDay `json:"days"`
+}
+
+type ValueHistoryPoint struct {
+	Date  string
+	Value int
+	Rank  int
+}
+
+// ValueDelta is one roster's value change over a lookback window
+type ValueDelta struct {
+	Label      string // "7d", "30d", "90d"
+	Days       int
+	Known      bool // False until the history reaches back far enough
+	Change     int
+	Pct        float64
+	RankChange int // Positive means the team climbed
+}
+
+// ValueChart holds precomputed SVG coordinates for the dashboard sparkline
+type ValueChart struct {
+	Width     int
+	Height    int
+	Points    string // SVG polyline points
+	Markers   []ValueChartMarker
+	Min       int
+	Max       int
+	FirstDate string
+	LastDate  string
+}
+
+type ValueChartMarker struct {
+	X     float64
+	Y     float64
+	Label string
+}
+
+// WeeklySnapshot is one archived league week, replayed by the backtest
+type WeeklySnapshot struct {
+	LeagueID        string                 `json:"league_id"`
+	Season          string                 `json:"season"`
+	Week            int                    `json:"week"`
+	SavedAt         time.Time              `json:"saved_at"`
+	League          LeagueData             `json:"league"`
+	FreeAgentsByPos map[string][]PlayerRow `json:"free_agents_by_pos"`
+}
+
+// BacktestOutcome scores one replayed recommendation against the player it displaced
+type BacktestOutcome struct {
+	LeagueID     string
+	Season       string
+	Week         int
+	Model        string // "waiver", "action-swap", "action-waiver"
+	Player       string
+	Versus       string
+	PlayerPoints float64
+	VersusPoints float64
+	Weeks        int // Weeks of scoring compared
+	Hit          bool
+	Score        int    // Waiver model score
+	Priority     string // Waiver model priority
+	BidPercent   int    // Suggested FAAB bid (percent of budget)
+}
+
+// BacktestStats is the hit rate for a group of outcomes
+type BacktestStats struct {
+	Label     string
+	Evaluated int
+	Hits      int
+	HitRate   float64 // Percent
+	AvgMargin float64 // Average points over the displaced player
+}
+
+// BacktestReport summarizes a backtest run
+type BacktestReport struct {
+	Horizon          int
+	Snapshots        int
+	Skipped          int // Snapshots whose week hasn't been played
+	Models           []BacktestStats
+	WaiverByPriority []BacktestStats
+	WaiverByBid      []BacktestStats
+	Outcomes         []BacktestOutcome
 }
@@ -0,0 +1,214 @@
+// ABOUTME: Durable per-league history of every team's daily roster value and value rank
+// ABOUTME: Provides 7/30/90-day deltas, rank movement, and sparkline points for the dashboard
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultValueHistoryDir = "/tmp/sleeperpy_value_history"
+	VALUE_HISTORY_MAX_DAYS = 400 // Enough for a full year of 90-day comparisons
+	valueHistoryDateFormat = "2006-01-02"
+)
+
+// valueHistoryWindows are the lookbacks shown on the dashboard
+var valueHistoryWindows = []int{7, 30, 90}
+
+// valueHistoryMu serializes read-modify-write of league history files
+var valueHistoryMu sync.Mutex
+
+// valueHistoryDir returns the history root, overridable with SLEEPERPY_VALUE_HISTORY_DIR
+func valueHistoryDir() string {
+	if dir := strings.TrimSpace(os.Getenv("SLEEPERPY_VALUE_HISTORY_DIR")); dir != "" {
+		return dir
+	}
+	return defaultValueHistoryDir
+}
+
+func valueHistoryPath(dir, leagueID string) string {
+	return filepath.Join(dir, sanitizePathSegment(leagueID)+".json")
+}
+
+// loadRosterValueHistory reads a league's history; a missing file is an empty history
+func loadRosterValueHistory(dir, leagueID string) (RosterValueHistory, error) {
+	history := RosterValueHistory{LeagueID: leagueID}
+	data, err := os.ReadFile(valueHistoryPath(dir, leagueID))
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return history, err
+	}
+	if err := json.Unmarshal(data, &history); err != nil {
+		return RosterValueHistory{LeagueID: leagueID}, fmt.Errorf("%s: %w", leagueID, err)
+	}
+	return history, nil
+}
+
+// recordRosterValues stores today's values for every roster and returns the updated history.
+// Repeat visits on the same day overwrite that day with the latest values.
+func recordRosterValues(dir, leagueID string, now time.Time, values map[int]int) (RosterValueHistory, error) {
+	valueHistoryMu.Lock()
+	defer valueHistoryMu.Unlock()
+
+	history, err := loadRosterValueHistory(dir, leagueID)
+	if err != nil {
+		// A corrupt file shouldn't block recording; start the series over
+		history = RosterValueHistory{LeagueID: leagueID}
+	}
+	upsertRosterValueDay(&history, now, values)
+	if writeErr := writeJSONFile(valueHistoryPath(dir, leagueID), history); writeErr != nil {
+		return history, writeErr
+	}
+	return history, err
+}
+
+// upsertRosterValueDay adds or replaces the entry for now's date, keeping days sorted and capped
+func upsertRosterValueDay(history *RosterValueHistory, now time.Time, values map[int]int) {
+	day := RosterValueDay{
+		Date:   now.Format(valueHistoryDateFormat),
+		Values: make(map[int]int, len(values)),
+		Ranks:  rankRosterValues(values),
+	}
+	for rid, v := range values {
+		day.Values[rid] = v
+	}
+
+	replaced := false
+	for i := range history.Days {
+		if history.Days[i].Date == day.Date {
+			history.Days[i] = day
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		history.Days = append(history.Days, day)
+		sort.Slice(history.Days, func(i, j int) bool { return history.Days[i].Date < history.Days[j].Date })
+	}
+	if len(history.Days) > VALUE_HISTORY_MAX_DAYS {
+		history.Days = history.Days[len(history.Days)-VALUE_HISTORY_MAX_DAYS:]
+	}
+}
+
+// rankRosterValues ranks rosters by value, highest first (ties broken by roster ID)
+func rankRosterValues(values map[int]int) map[int]int {
+	ids := make([]int, 0, len(values))
+	for rid := range values {
+		ids = append(ids, rid)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if values[ids[i]] != values[ids[j]] {
+			return values[ids[i]] > values[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	ranks := make(map[int]int, len(ids))
+	for i, rid := range ids {
+		ranks[rid] = i + 1
+	}
+	return ranks
+}
+
+// rosterValueSeries returns one roster's points in date order
+func rosterValueSeries(history RosterValueHistory, rosterID int) []ValueHistoryPoint {
+	points := []ValueHistoryPoint{}
+	for _, day := range history.Days {
+		if v, ok := day.Values[rosterID]; ok {
+			points = append(points, ValueHistoryPoint{Date: day.Date, Value: v, Rank: day.Ranks[rosterID]})
+		}
+	}
+	return points
+}
+
+// valueDeltas compares a roster's latest value with the last recorded day at least N days earlier
+func valueDeltas(history RosterValueHistory, rosterID int, now time.Time) []ValueDelta {
+	series := rosterValueSeries(history, rosterID)
+	deltas := make([]ValueDelta, 0, len(valueHistoryWindows))
+	for _, days := range valueHistoryWindows {
+		d := ValueDelta{Label: fmt.Sprintf("%dd", days), Days: days}
+		if len(series) > 0 {
+			latest := series[len(series)-1]
+			cutoff := now.AddDate(0, 0, -days).Format(valueHistoryDateFormat)
+			for i := len(series) - 1; i >= 0; i-- {
+				if series[i].Date > cutoff {
+					continue
+				}
+				base := series[i]
+				d.Known = true
+				d.Change = latest.Value - base.Value
+				if base.Value > 0 {
+					d.Pct = float64(d.Change) / float64(base.Value) * 100
+				}
+				d.RankChange = base.Rank - latest.Rank
+				break
+			}
+		}
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
+
+// valueTrendLabel summarizes the shortest known window, falling back to the oldest recorded day
+func valueTrendLabel(series []ValueHistoryPoint, deltas []ValueDelta) string {
+	pct := 0.0
+	found := false
+	for _, d := range deltas {
+		if d.Known {
+			pct, found = d.Pct, true
+			break
+		}
+	}
+	if !found && len(series) > 1 && series[0].Value > 0 {
+		first, latest := series[0], series[len(series)-1]
+		pct = float64(latest.Value-first.Value) / float64(first.Value) * 100
+	}
+	if pct >= 1.0 {
+		return fmt.Sprintf("↗ +%.0f%%", pct)
+	} else if pct <= -1.0 {
+		return fmt.Sprintf("↘ %.0f%%", pct)
+	}
+	return "→ stable"
+}
+
+// buildValueChart scales a series into SVG polyline coordinates
+func buildValueChart(series []ValueHistoryPoint, width, height int) ValueChart {
+	chart := ValueChart{Width: width, Height: height}
+	if len(series) < 2 {
+		return chart
+	}
+	chart.Min, chart.Max = series[0].Value, series[0].Value
+	for _, p := range series {
+		if p.Value < chart.Min {
+			chart.Min = p.Value
+		}
+		if p.Value > chart.Max {
+			chart.Max = p.Value
+		}
+	}
+	span := float64(chart.Max - chart.Min)
+	pad := 4.0
+	coords := make([]string, 0, len(series))
+	for i, p := range series {
+		x := pad + float64(i)*(float64(width)-2*pad)/float64(len(series)-1)
+		y := float64(height) / 2
+		if span > 0 {
+			y = pad + (1-float64(p.Value-chart.Min)/span)*(float64(height)-2*pad)
+		}
+		coords = append(coords, fmt.Sprintf("%.1f,%.1f", x, y))
+		chart.Markers = append(chart.Markers, ValueChartMarker{X: x, Y: y, Label: fmt.Sprintf("%s: %d (#%d)", p.Date, p.Value, p.Rank)})
+	}
+	chart.Points = strings.Join(coords, " ")
+	chart.FirstDate = series[0].Date
+	chart.LastDate = series[len(series)-1].Date
+	return chart
+}
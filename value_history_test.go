@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecordRosterValuesPersistsAndUpserts(t *testing.T) {
+	dir := t.TempDir()
+	day1 := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	if _, err := recordRosterValues(dir, "L1", day1, map[int]int{1: 5000, 2: 6000}); err != nil {
+		t.Fatalf("record failed: %v", err)
+	}
+	// Same day again: replaces rather than appends
+	if _, err := recordRosterValues(dir, "L1", day1.Add(time.Hour), map[int]int{1: 7000, 2: 6000}); err != nil {
+		t.Fatalf("record failed: %v", err)
+	}
+	history, err := loadRosterValueHistory(dir, "L1")
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(history.Days) != 1 || history.Days[0].Values[1] != 7000 || history.Days[0].Ranks[1] != 1 {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+
+	empty, err := loadRosterValueHistory(dir, "missing")
+	if err != nil || len(empty.Days) != 0 {
+		t.Fatalf("expected empty history for unknown league, got %+v %v", empty, err)
+	}
+}
+
+func TestUpsertRosterValueDayCapsHistory(t *testing.T) {
+	history := RosterValueHistory{}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < VALUE_HISTORY_MAX_DAYS+5; i++ {
+		upsertRosterValueDay(&history, start.AddDate(0, 0, i), map[int]int{1: i})
+	}
+	if len(history.Days) != VALUE_HISTORY_MAX_DAYS {
+		t.Fatalf("expected %d days, got %d", VALUE_HISTORY_MAX_DAYS, len(history.Days))
+	}
+	if history.Days[0].Date != start.AddDate(0, 0, 5).Format(valueHistoryDateFormat) {
+		t.Fatalf("expected oldest days dropped, first is %s", history.Days[0].Date)
+	}
+}
+
+func TestValueDeltasAndTrend(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	history := RosterValueHistory{}
+	upsertRosterValueDay(&history, now.AddDate(0, 0, -40), map[int]int{1: 4000, 2: 5000})
+	upsertRosterValueDay(&history, now.AddDate(0, 0, -8), map[int]int{1: 4500, 2: 5000})
+	upsertRosterValueDay(&history, now, map[int]int{1: 5500, 2: 5000})
+
+	deltas := valueDeltas(history, 1, now)
+	if len(deltas) != 3 {
+		t.Fatalf("expected 3 windows, got %+v", deltas)
+	}
+	if !deltas[0].Known || deltas[0].Change != 1000 || deltas[0].RankChange != 1 {
+		t.Fatalf("unexpected 7d delta: %+v", deltas[0])
+	}
+	if !deltas[1].Known || deltas[1].Change != 1500 {
+		t.Fatalf("unexpected 30d delta: %+v", deltas[1])
+	}
+	if deltas[2].Known {
+		t.Fatalf("expected 90d window to be unknown with 40 days of history: %+v", deltas[2])
+	}
+
+	series := rosterValueSeries(history, 1)
+	if got := valueTrendLabel(series, deltas); got != "↗ +22%" {
+		t.Fatalf("unexpected trend label %q", got)
+	}
+	// Only a few days of history: fall back to the oldest point
+	short := []ValueHistoryPoint{{Value: 1000}, {Value: 900}}
+	if got := valueTrendLabel(short, []ValueDelta{{}}); got != "↘ -10%" {
+		t.Fatalf("unexpected fallback trend %q", got)
+	}
+}
+
+func TestBuildValueChart(t *testing.T) {
+	if chart := buildValueChart([]ValueHistoryPoint{{Value: 10}}, 100, 40); chart.Points != "" {
+		t.Fatalf("expected no chart for a single point, got %+v", chart)
+	}
+	chart := buildValueChart([]ValueHistoryPoint{
+		{Date: "2026-09-01", Value: 100, Rank: 3},
+		{Date: "2026-09-02", Value: 300, Rank: 1},
+	}, 100, 40)
+	if chart.Min != 100 || chart.Max != 300 || chart.Points != "4.0,36.0 96.0,4.0" {
+		t.Fatalf("unexpected chart: %+v", chart)
+	}
+	if !strings.Contains(chart.Markers[1].Label, "#1") {
+		t.Fatalf("expected rank in marker label: %+v", chart.Markers)
+	}
+}
@@ -5,9 +5,60 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"sort"
 )
 
+// Sleeper waiver_type values
+const (
+	WAIVER_TYPE_ROLLING = 0
+	WAIVER_TYPE_REVERSE = 1
+	WAIVER_TYPE_FAAB    = 2
+)
+
+// parseWaiverBudget reads the league's waiver system and every roster's remaining FAAB
+func parseWaiverBudget(league map[string]interface{}, rosters []map[string]interface{}, userRoster map[string]interface{}, week int) WaiverBudget {
+	budget := WaiverBudget{WaiverType: WAIVER_TYPE_ROLLING, Teams: len(rosters)}
+	if settings, ok := league["settings"].(map[string]interface{}); ok {
+		if v, ok := settings["waiver_type"].(float64); ok {
+			budget.WaiverType = int(v)
+		}
+		if v, ok := settings["waiver_budget"].(float64); ok {
+			budget.Budget = int(v)
+		}
+	}
+	budget.IsFAAB = budget.WaiverType == WAIVER_TYPE_FAAB && budget.Budget > 0
+
+	userRosterID, _ := userRoster["roster_id"].(float64)
+	budget.UserRemaining = budget.Budget - int(rosterSettingFloat(userRoster, "waiver_budget_used"))
+	budget.UserPriority = int(rosterSettingFloat(userRoster, "waiver_position"))
+
+	opponents := 0
+	totalRemaining := 0
+	for _, r := range rosters {
+		if rid, _ := r["roster_id"].(float64); rid == userRosterID {
+			continue
+		}
+		remaining := budget.Budget - int(rosterSettingFloat(r, "waiver_budget_used"))
+		totalRemaining += remaining
+		opponents++
+		if remaining > budget.MaxOpponentRemaining {
+			budget.MaxOpponentRemaining = remaining
+		}
+	}
+	if opponents > 0 {
+		budget.AvgOpponentRemaining = totalRemaining / opponents
+	}
+
+	playoffStart := leaguePlayoffWeekStart(league)
+	budget.RegularSeasonWeeks = playoffStart - 1
+	budget.Week = week
+	if week > 0 && week < playoffStart {
+		budget.WeeksRemaining = playoffStart - week
+	}
+	return budget
+}
+
 // Generate waiver recommendations for a league
 func generateWaiverRecommendations(
 	league LeagueData,
@@ -57,6 +108,9 @@ func scoreWaiverTarget(fa PlayerRow, league LeagueData, position string, availab
 	rationale := ""
 	role := classifyWaiverRole(fa, impactType)
 	usageSignal := usageSignal(fa.RosterPercent)
+	if trend := trendSignal(fa.TrendingAdds, fa.TrendingDrops); trend != "" {
+		usageSignal = trend + ", " + usageSignal
+	}
 
 	faTier := parseTierFloat(fa.Tier)
 	if faTier == 0 {
@@ -128,6 +182,9 @@ func scoreWaiverTarget(fa PlayerRow, league LeagueData, position string, availab
 		score += 4
 	}
 
+	// 3c. Trending bonus: Sleeper-wide adds move before roster percentage catches up
+	score += trendingWaiverBonus(fa.TrendingAdds, fa.TrendingDrops)
+
 	// 4. Dynasty value bonus (if dynasty league)
 	if league.IsDynasty && fa.DynastyValue > 0 {
 		if fa.DynastyValue > 1000 {
@@ -163,8 +220,22 @@ func scoreWaiverTarget(fa PlayerRow, league LeagueData, position string, availab
 		priority = "Medium"
 	}
 
-	// Calculate suggested FAAB bid (percentage of budget)
+	// Calculate suggested FAAB bid (percentage of budget), then dollars or priority advice
 	suggestedBid := calculateFAABBid(score, impactType, league.HasMatchups)
+	bidDollars := 0
+	bidAdvice := ""
+	var market FAABEstimate
+	hasMarket := false
+	if league.WaiverBudget.IsFAAB {
+		bidDollars = faabBidDollars(suggestedBid, league.WaiverBudget)
+		// Prefer what this league actually pays once there's enough history
+		market, hasMarket = league.FAABMarket.estimateBid(fa.Pos, int(faTier), league.WaiverBudget.Week, priority, league.WaiverBudget)
+		if hasMarket {
+			bidDollars = market.Bid
+		}
+	} else {
+		bidAdvice = waiverPriorityAdvice(priority, league.WaiverBudget)
+	}
 
 	// Build rationale if not set
 	if rationale == "" {
@@ -178,11 +249,20 @@ func scoreWaiverTarget(fa PlayerRow, league LeagueData, position string, availab
 		role = classifyWaiverRole(fa, impactType)
 	}
 
+	drop, needsDrop, rosterWarning := pairDropCandidate(fa, league)
+
 	return WaiverRecommendation{
 		Player:           fa,
 		Score:            score,
 		Priority:         priority,
 		SuggestedBid:     suggestedBid,
+		BidDollars:       bidDollars,
+		BidAdvice:        bidAdvice,
+		Market:           market,
+		HasMarket:        hasMarket,
+		NeedsDrop:        needsDrop,
+		Drop:             drop,
+		RosterWarning:    rosterWarning,
 		Rationale:        rationale,
 		ImpactType:       impactType,
 		Role:             role,
@@ -228,6 +308,59 @@ func calculateFAABBid(score int, impactType string, inSeason bool) int {
 	return bid
 }
 
+// faabBidDollars converts a bid percentage into dollars from the user's remaining budget.
+// Bids rise when rivals have more to spend than the user and as the regular season runs out.
+func faabBidDollars(bidPct int, b WaiverBudget) int {
+	if bidPct <= 0 || b.UserRemaining <= 0 {
+		return 0
+	}
+	bid := float64(b.UserRemaining) * float64(bidPct) / 100
+
+	// Competition: scale toward what rivals can afford (bounded so one rich team can't dominate)
+	competition := 1.0
+	if b.AvgOpponentRemaining > 0 {
+		competition = math.Max(0.6, math.Min(1.5, float64(b.AvgOpponentRemaining)/float64(b.UserRemaining)))
+	}
+	bid *= competition
+
+	// Urgency: unspent budget is worth less with fewer weeks left
+	if b.RegularSeasonWeeks > 0 {
+		elapsed := 1 - float64(b.WeeksRemaining)/float64(b.RegularSeasonWeeks)
+		bid *= 1 + 0.5*math.Max(0, math.Min(1, elapsed))
+	}
+
+	dollars := int(math.Round(bid))
+	if dollars < 1 {
+		dollars = 1
+	}
+	if dollars > b.UserRemaining {
+		dollars = b.UserRemaining
+	}
+	return dollars
+}
+
+// waiverPriorityAdvice tells rolling and reverse-standings leagues whether a claim is worth the priority
+func waiverPriorityAdvice(priority string, b WaiverBudget) string {
+	if b.WaiverType == WAIVER_TYPE_REVERSE {
+		return "Claim it - priority resets with standings"
+	}
+	topThird := b.Teams / 3
+	if topThird < 1 {
+		topThird = 1
+	}
+	switch {
+	case priority == "High":
+		if b.UserPriority > 0 {
+			return fmt.Sprintf("Burn priority (#%d)", b.UserPriority)
+		}
+		return "Burn priority"
+	case b.UserPriority > 0 && b.UserPriority <= topThird:
+		return fmt.Sprintf("Hold priority (#%d) - add after waivers clear", b.UserPriority)
+	default:
+		return "Claim it - little priority to lose"
+	}
+}
+
 func classifyWaiverRole(fa PlayerRow, impactType string) string {
 	if impactType == "Starter Upgrade" {
 		return "Immediate Starter"
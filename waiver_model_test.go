@@ -38,3 +38,71 @@ func TestClassifyWaiverRole(t *testing.T) {
 		t.Fatalf("unexpected stash role: %q", got)
 	}
 }
+
+func TestParseWaiverBudget(t *testing.T) {
+	league := map[string]interface{}{
+		"settings": map[string]interface{}{"waiver_type": float64(2), "waiver_budget": float64(100), "playoff_week_start": float64(15)},
+	}
+	rosters := []map[string]interface{}{
+		{"roster_id": float64(1), "settings": map[string]interface{}{"waiver_budget_used": float64(60), "waiver_position": float64(4)}},
+		{"roster_id": float64(2), "settings": map[string]interface{}{"waiver_budget_used": float64(10)}},
+		{"roster_id": float64(3), "settings": map[string]interface{}{"waiver_budget_used": float64(50)}},
+	}
+	b := parseWaiverBudget(league, rosters, rosters[0], 10)
+	if !b.IsFAAB || b.UserRemaining != 40 || b.UserPriority != 4 {
+		t.Fatalf("unexpected user budget: %+v", b)
+	}
+	if b.AvgOpponentRemaining != 70 || b.MaxOpponentRemaining != 90 {
+		t.Fatalf("unexpected opponent budgets: %+v", b)
+	}
+	if b.WeeksRemaining != 5 || b.RegularSeasonWeeks != 14 {
+		t.Fatalf("unexpected weeks: %+v", b)
+	}
+}
+
+func TestFAABBidDollars(t *testing.T) {
+	base := WaiverBudget{IsFAAB: true, Budget: 100, UserRemaining: 100, AvgOpponentRemaining: 100, WeeksRemaining: 14, RegularSeasonWeeks: 14}
+	if got := faabBidDollars(25, base); got != 25 {
+		t.Fatalf("expected $25 at start of season, got %d", got)
+	}
+
+	poorRivals := base
+	poorRivals.AvgOpponentRemaining = 30
+	if got := faabBidDollars(25, poorRivals); got >= 25 {
+		t.Fatalf("expected lower bid when rivals are short on budget, got %d", got)
+	}
+
+	late := base
+	late.WeeksRemaining = 1
+	if got := faabBidDollars(25, late); got <= 25 {
+		t.Fatalf("expected higher bid late in the season, got %d", got)
+	}
+
+	broke := base
+	broke.UserRemaining = 3
+	broke.AvgOpponentRemaining = 80
+	if got := faabBidDollars(40, broke); got > 3 {
+		t.Fatalf("bid must not exceed remaining budget, got %d", got)
+	}
+	if got := faabBidDollars(0, base); got != 0 {
+		t.Fatalf("expected no bid for zero percent, got %d", got)
+	}
+}
+
+func TestWaiverPriorityAdvice(t *testing.T) {
+	rolling := WaiverBudget{WaiverType: WAIVER_TYPE_ROLLING, Teams: 12, UserPriority: 2}
+	if got := waiverPriorityAdvice("High", rolling); got != "Burn priority (#2)" {
+		t.Fatalf("unexpected high-priority advice: %q", got)
+	}
+	if got := waiverPriorityAdvice("Low", rolling); got != "Hold priority (#2) - add after waivers clear" {
+		t.Fatalf("unexpected hold advice: %q", got)
+	}
+	rolling.UserPriority = 11
+	if got := waiverPriorityAdvice("Low", rolling); got != "Claim it - little priority to lose" {
+		t.Fatalf("unexpected low-priority advice: %q", got)
+	}
+	reverse := WaiverBudget{WaiverType: WAIVER_TYPE_REVERSE, Teams: 12}
+	if got := waiverPriorityAdvice("Low", reverse); got != "Claim it - priority resets with standings" {
+		t.Fatalf("unexpected reverse advice: %q", got)
+	}
+}
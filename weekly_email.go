@@ -114,8 +114,8 @@ func buildWeeklyEmailSummary(page *DashboardPage) string {
 func deriveSummaryRisks(page *DashboardPage) []string {
 	risks := []string{}
 	for _, l := range page.LeagueSummaries {
-		if strings.Contains(strings.ToLower(l.PlayoffStatus), "eliminated") {
-			risks = append(risks, fmt.Sprintf("%s: eliminated from contention", l.LeagueName))
+		if strings.Contains(l.PlayoffStatus, "<1%") {
+			risks = append(risks, fmt.Sprintf("%s: under 1%% playoff odds", l.LeagueName))
 		}
 		if strings.Contains(l.ValueTrend, "↘") {
 			risks = append(risks, fmt.Sprintf("%s: negative roster value trend (%s)", l.LeagueName, l.ValueTrend))
@@ -50,9 +50,9 @@ func TestBuildWeeklyEmailSummary(t *testing.T) {
 func TestDeriveSummaryRisks(t *testing.T) {
 	page := &DashboardPage{
 		LeagueSummaries: []LeagueSummary{
-			{LeagueName: "L1", PlayoffStatus: "Eliminated", ValueTrend: "↘ -3%", ActionCount: 5},
-			{LeagueName: "L2", PlayoffStatus: "Eliminated", ValueTrend: "↘ -2%", ActionCount: 4},
-			{LeagueName: "L3", PlayoffStatus: "Eliminated", ValueTrend: "↘ -1%", ActionCount: 6},
+			{LeagueName: "L1", PlayoffStatus: "Long Shot (<1%)", ValueTrend: "↘ -3%", ActionCount: 5},
+			{LeagueName: "L2", PlayoffStatus: "Long Shot (<1%)", ValueTrend: "↘ -2%", ActionCount: 4},
+			{LeagueName: "L3", PlayoffStatus: "Long Shot (<1%)", ValueTrend: "↘ -1%", ActionCount: 6},
 		},
 	}
 	risks := deriveSummaryRisks(page)